@@ -0,0 +1,176 @@
+package stringo
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	initialismsMutex sync.RWMutex
+	// initialisms are the words kept uppercase by ToCamel, ToPascal and ToTrainCase. I.E: "user_id" becomes "UserID"
+	initialisms = wordSet("ACL", "API", "ASCII", "CPU", "CSS", "CSV", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID",
+		"IP", "JSON", "JWT", "LHS", "PDF", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS", "TTL",
+		"UDP", "UI", "UID", "URI", "URL", "UTF8", "UUID", "VM", "XML", "XMPP", "XSRF", "XSS")
+)
+
+// SetInitialisms replaces the list of words kept uppercase by ToCamel, ToPascal and ToTrainCase
+// Calling it without arguments disables initialisms, so "user_id" becomes "UserId" instead of "UserID"
+func SetInitialisms(words ...string) {
+	set := make(map[string]bool, len(words))
+
+	for _, w := range words {
+		set[strings.ToUpper(w)] = true
+	}
+
+	initialismsMutex.Lock()
+	initialisms = set
+	initialismsMutex.Unlock()
+}
+
+// AddInitialisms appends words to the list kept uppercase by ToCamel, ToPascal and ToTrainCase
+// Example: AddInitialisms("CPF", "CNPJ") makes ToPascal("cpf_number") return "CPFNumber"
+func AddInitialisms(words ...string) {
+	initialismsMutex.Lock()
+
+	for _, w := range words {
+		initialisms[strings.ToUpper(w)] = true
+	}
+
+	initialismsMutex.Unlock()
+}
+
+// isInitialism returns true if the given word is on the initialisms list
+func isInitialism(word string) bool {
+	initialismsMutex.RLock()
+	defer initialismsMutex.RUnlock()
+
+	return initialisms[strings.ToUpper(word)]
+}
+
+// caseWords splits the given string into words, considering separators and case changes
+// Any rune other than letters and digits is a separator. Acronyms are kept together. I.E: "HTTPServerID" gives "HTTP", "Server" and "ID"
+func caseWords(s string) []string {
+	var (
+		words []string
+		rs    = []rune(s)
+		start = -1
+	)
+
+	for i, r := range rs {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(rs[start:i]))
+				start = -1
+			}
+
+			continue
+		}
+
+		if start < 0 {
+			start = i
+			continue
+		}
+
+		if unicode.IsUpper(r) {
+			prev := rs[i-1]
+
+			// camelCase and digit8Upper boundaries, or the end of an acronym, like the "S" in "HTTPServer"
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(rs) && unicode.IsLower(rs[i+1])) {
+				words = append(words, string(rs[start:i]))
+				start = i
+			}
+		}
+	}
+
+	if start >= 0 {
+		words = append(words, string(rs[start:]))
+	}
+
+	return words
+}
+
+// capitalize returns the given word with the first letter uppercase and the rest lowercase, or all uppercase if it's an initialism
+func capitalize(word string) string {
+	if isInitialism(word) {
+		return strings.ToUpper(word)
+	}
+
+	r, size := utf8.DecodeRuneInString(word)
+
+	return string(unicode.ToTitle(r)) + strings.ToLower(word[size:])
+}
+
+// joinCaseWords transforms every word, and joins them with the given separator
+func joinCaseWords(s, separator string, fn func(i int, word string) string) string {
+	words := caseWords(s)
+
+	for i, w := range words {
+		words[i] = fn(i, w)
+	}
+
+	return strings.Join(words, separator)
+}
+
+// ToCamel converts the given string to camelCase, keeping initialisms uppercase
+// Example: ToCamel("user id") returns "userID"
+// Example: ToCamel("HTTPServer") returns "httpServer"
+func ToCamel(s string) string {
+	return joinCaseWords(s, "", func(i int, word string) string {
+		if i == 0 {
+			return strings.ToLower(word)
+		}
+
+		return capitalize(word)
+	})
+}
+
+// ToPascal converts the given string to PascalCase, keeping initialisms uppercase
+// Example: ToPascal("http_server_id") returns "HTTPServerID"
+func ToPascal(s string) string {
+	return joinCaseWords(s, "", func(_ int, word string) string {
+		return capitalize(word)
+	})
+}
+
+// ToSnake converts the given string to snake_case
+// Example: ToSnake("HTTPServerID") returns "http_server_id"
+func ToSnake(s string) string {
+	return joinCaseWords(s, "_", func(_ int, word string) string {
+		return strings.ToLower(word)
+	})
+}
+
+// ToKebab converts the given string to kebab-case
+// Example: ToKebab("HTTPServerID") returns "http-server-id"
+func ToKebab(s string) string {
+	return joinCaseWords(s, "-", func(_ int, word string) string {
+		return strings.ToLower(word)
+	})
+}
+
+// ToScreamingSnake converts the given string to SCREAMING_SNAKE_CASE, also known as constant case
+// Example: ToScreamingSnake("maxRetryCount") returns "MAX_RETRY_COUNT"
+func ToScreamingSnake(s string) string {
+	return joinCaseWords(s, "_", func(_ int, word string) string {
+		return strings.ToUpper(word)
+	})
+}
+
+// ToDotCase converts the given string to dot.case
+// Example: ToDotCase("serverHTTPPort") returns "server.http.port"
+func ToDotCase(s string) string {
+	return joinCaseWords(s, ".", func(_ int, word string) string {
+		return strings.ToLower(word)
+	})
+}
+
+// ToTrainCase converts the given string to Train-Case, keeping initialisms uppercase
+// Example: ToTrainCase("content_type") returns "Content-Type"
+func ToTrainCase(s string) string {
+	return joinCaseWords(s, "-", func(_ int, word string) string {
+		return capitalize(word)
+	})
+}
@@ -0,0 +1,78 @@
+package stringo
+
+import "testing"
+
+func TestCaseStyles(t *testing.T) {
+	tcs := []struct {
+		summary  string
+		input    string
+		camel    string
+		pascal   string
+		snake    string
+		kebab    string
+		screamin string
+		dot      string
+		train    string
+	}{
+		{"empty", "", "", "", "", "", "", "", ""},
+		{"acronyms", "HTTPServerID", "httpServerID", "HTTPServerID", "http_server_id", "http-server-id", "HTTP_SERVER_ID", "http.server.id", "HTTP-Server-ID"},
+		{"spaces", "user first name", "userFirstName", "UserFirstName", "user_first_name", "user-first-name", "USER_FIRST_NAME", "user.first.name", "User-First-Name"},
+		{"snake to others", "api_base_url", "apiBaseURL", "APIBaseURL", "api_base_url", "api-base-url", "API_BASE_URL", "api.base.url", "API-Base-URL"},
+		{"camel to others", "maxRetryCount", "maxRetryCount", "MaxRetryCount", "max_retry_count", "max-retry-count", "MAX_RETRY_COUNT", "max.retry.count", "Max-Retry-Count"},
+		{"digits", "utf8Decoder v2", "utf8DecoderV2", "UTF8DecoderV2", "utf8_decoder_v2", "utf8-decoder-v2", "UTF8_DECODER_V2", "utf8.decoder.v2", "UTF8-Decoder-V2"},
+		{"unicode", "ÁrvoreGenealógica ção", "árvoreGenealógicaÇão", "ÁrvoreGenealógicaÇão", "árvore_genealógica_ção", "árvore-genealógica-ção", "ÁRVORE_GENEALÓGICA_ÇÃO", "árvore.genealógica.ção", "Árvore-Genealógica-Ção"},
+		{"noisy separators", "--content__type--", "contentType", "ContentType", "content_type", "content-type", "CONTENT_TYPE", "content.type", "Content-Type"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			results := []struct {
+				style, expected, got string
+			}{
+				{"camel", tc.camel, ToCamel(tc.input)},
+				{"pascal", tc.pascal, ToPascal(tc.input)},
+				{"snake", tc.snake, ToSnake(tc.input)},
+				{"kebab", tc.kebab, ToKebab(tc.input)},
+				{"screaming snake", tc.screamin, ToScreamingSnake(tc.input)},
+				{"dot", tc.dot, ToDotCase(tc.input)},
+				{"train", tc.train, ToTrainCase(tc.input)},
+			}
+
+			for _, r := range results {
+				if r.got != r.expected {
+					t.Errorf("Test has failed!\n\tInput: %s,\n\tStyle: %s,\n\tExpected: %s, \n\tGot: %s", tc.input, r.style, r.expected, r.got)
+				}
+			}
+		})
+	}
+}
+
+func TestInitialisms(t *testing.T) {
+	original := initialisms
+
+	defer func() {
+		initialisms = original
+	}()
+
+	SetInitialisms()
+
+	if r := ToPascal("user_id"); r != "UserId" {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s", "UserId", r)
+	}
+
+	AddInitialisms("cpf")
+
+	if r := ToPascal("cpf_number"); r != "CPFNumber" {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s", "CPFNumber", r)
+	}
+}
+
+func TestTransformCaseStyles(t *testing.T) {
+	if r := Transform("  Max Retry Count  ", 0, TransformTrim|TransformSnakeCase); r != "max_retry_count" {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s", "max_retry_count", r)
+	}
+
+	if r := TransformSerially("São Paulo city", 0, TransformTransliterate, TransformKebabCase); r != "sao-paulo-city" {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s", "sao-paulo-city", r)
+	}
+}
@@ -75,6 +75,9 @@ const (
 	ChkRequireUpperCase ChkRule = 8192
 	// ChkRequireLowercase demands at least 1 lowercase letter within string
 	ChkRequireLowercase ChkRule = 16384
+	// ChkDenyMixedScripts forbids mixing letters from different writing systems, like Latin and Cyrillic, as spoofers do
+	// Unlike ChkDenyUnicode, it accepts accented letters and any single non-Latin script. See HasMixedScripts()
+	ChkDenyMixedScripts ChkRule = 32768
 
 	// ChkOk means "alright"
 	ChkOk ChkResult = 0
@@ -113,6 +116,8 @@ const (
 	ChkUpperCaseNotFound ChkResult = -17
 	// ChkLowercaseNotFound is self explained
 	ChkLowercaseNotFound ChkResult = -18
+	// ChkMixedScriptsDenied is self explained
+	ChkMixedScriptsDenied ChkResult = -19
 )
 
 var (
@@ -224,6 +229,12 @@ func CheckStr(seq string, minLen, maxLen uint, rules ChkRule) ChkResult {
 		}
 	}
 
+	if rules&ChkDenyMixedScripts == ChkDenyMixedScripts {
+		if HasMixedScripts(seq) {
+			return ChkMixedScriptsDenied
+		}
+	}
+
 	if rules&ChkRequireNumbers == ChkRequireNumbers {
 		if !containsNumbers {
 			return ChkNumbersNotFound
@@ -31,6 +31,10 @@ func TestCheckStr(t *testing.T) {
 		{"denies uppercase", "upper Case", 0, 100, ChkDenyUpperCase, ChkUpperCaseDenied},
 		{"denies lowercase", "LOWER cASE", 0, 100, ChkDenyLowercase, ChkLowercaseDenied},
 		{"denies unicode", "TAB	ÇÂÖÉд", 0, 100, ChkDenyUnicode, ChkUnicodeDenied},
+		{"denies mixed scripts", "pаypal", 0, 100, ChkDenyMixedScripts, ChkMixedScriptsDenied},
+		{"accepts accented latin as a single script", "São Paulo 123", 0, 100, ChkDenyMixedScripts, ChkOk},
+		{"accepts a single non-latin script", "Москва", 0, 100, ChkDenyMixedScripts, ChkOk},
+		{"accepts han mixed with japanese kana", "東京タワー", 0, 100, ChkDenyMixedScripts, ChkOk},
 		{"missed numbers", "NO NUMBERS", 0, 100, ChkRequireNumbers, ChkNumbersNotFound},
 		{"missed letters", " 87 %323232	", 0, 100, ChkRequireLetters, ChkLettersNotFound},
 		{"missed symbols", "NO SYMBOLS 123", 0, 100, ChkRequireSymbols, ChkSymbolsNotFound},
@@ -0,0 +1,167 @@
+package stringo
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusablePrototypes maps look-alike runes to their prototypes, as listed on Unicode's confusables.txt
+// It's a subset focused on Latin, Cyrillic, Greek and digits, which are the usual suspects of spoofing.
+// Compatibility variants, like fullwidth and mathematical letters, are handled by NFKD instead.
+var confusablePrototypes = map[rune]string{
+	// Latin and common
+	'0': "O", '1': "l", 'I': "l", '|': "l", 'ǀ': "l", 'ℓ': "l",
+	'm': "rn", 'ı': "i", 'ɩ': "i", 'ɑ': "a", 'ɡ': "g",
+	'ʏ': "y", 'ᴄ': "c", 'ᴏ': "o", 'ᴠ': "v", 'ᴡ': "w", 'ᴢ': "z",
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '−': "-", '˗': "-",
+	'‘': "'", '’': "'", '‛': "'", 'ʹ': "'", 'ʼ': "'", '´': "'",
+	'“': "''", '”': "''", '"': "''",
+
+	// Cyrillic
+	'а': "a", 'А': "A", 'В': "B", 'е': "e", 'Е': "E",
+	'Ѕ': "S", 'ѕ': "s", 'І': "l", 'і': "i",
+	'Ј': "J", 'ј': "j", 'К': "K", 'М': "M", 'Н': "H", 'О': "O", 'о': "o",
+	'Р': "P", 'р': "p", 'С': "C", 'с': "c", 'Т': "T", 'у': "y", 'Ү': "Y",
+	'Х': "X", 'х': "x", 'ԁ': "d", 'Ԁ': "d", 'ԛ': "q", 'Ԛ': "Q", 'ԝ': "w",
+	'Ԝ': "W", 'һ': "h", 'Һ': "h", 'ӏ': "l", 'Ӏ': "l", 'ь': "b",
+	'Ь': "b", 'п': "n", 'г': "r", 'ѵ': "v", 'Ѵ': "V", 'ү': "y",
+
+	// Greek
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "l", 'Κ': "K",
+	'Μ': "M", 'Ν': "N", 'Ο': "O", 'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X",
+	'α': "a", 'ο': "o", 'ν': "v", 'ρ': "p", 'υ': "u", 'ι': "i", 'ϲ': "c",
+	'Ϲ': "C", 'ϳ': "j", 'γ': "y", 'σ': "o",
+}
+
+// confusablePrototype returns the prototype of a single rune, according confusablePrototypes and compatibility decomposition
+func confusablePrototype(r rune) string {
+	if p, ok := confusablePrototypes[r]; ok {
+		return p
+	}
+
+	// Fullwidth, mathematical and other compatibility variants share the prototype of what they decompose to
+	if d := norm.NFKD.String(string(r)); d != string(r) {
+		var sb strings.Builder
+
+		for _, x := range d {
+			if p, ok := confusablePrototypes[x]; ok {
+				sb.WriteString(p)
+			} else {
+				sb.WriteRune(x)
+			}
+		}
+
+		return sb.String()
+	}
+
+	return string(r)
+}
+
+// ConfusableSkeleton returns the skeleton of the given string, as defined on Unicode Technical Standard #39
+// Two strings with the same skeleton are visually confusable. I.E: "pаypal" (with a Cyrillic "а") and "paypal"
+// The skeleton is meant for comparison only, never for display.
+// See https://www.unicode.org/reports/tr39/#Confusable_Detection for details
+func ConfusableSkeleton(s string) string {
+	if s == "" {
+		return s
+	}
+
+	var sb strings.Builder
+
+	for _, r := range norm.NFD.String(s) {
+		sb.WriteString(confusablePrototype(r))
+	}
+
+	return norm.NFD.String(sb.String())
+}
+
+// IsConfusable returns true if the given strings look alike, which means they share the same skeleton
+// Example: IsConfusable("pаypal", "paypal") returns true, since the first "а" is Cyrillic
+func IsConfusable(a, b string) bool {
+	return ConfusableSkeleton(a) == ConfusableSkeleton(b)
+}
+
+// Augmented script sets, as defined on UTS #39, allowing Han to be mixed with Japanese and Korean scripts
+const (
+	scriptJapanese = "Jpan"
+	scriptKorean   = "Kore"
+	scriptHanBopo  = "Hanb"
+)
+
+// runeScripts returns the (augmented) set of scripts the given rune belongs to
+// Common and Inherited runes, like digits, punctuation and combining marks, return nil, since they fit any script
+func runeScripts(r rune) []string {
+	if r <= unicode.MaxASCII {
+		if unicode.IsLetter(r) {
+			return []string{"Latin"}
+		}
+
+		return nil
+	}
+
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return nil
+	}
+
+	switch {
+	case unicode.Is(unicode.Latin, r):
+		return []string{"Latin"}
+	case unicode.Is(unicode.Han, r):
+		return []string{"Han", scriptJapanese, scriptKorean, scriptHanBopo}
+	case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+		return []string{scriptJapanese}
+	case unicode.Is(unicode.Hangul, r):
+		return []string{scriptKorean}
+	case unicode.Is(unicode.Bopomofo, r):
+		return []string{scriptHanBopo}
+	}
+
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return []string{name}
+		}
+	}
+
+	return nil
+}
+
+// HasMixedScripts returns true if the given string mixes letters from different writing systems, like Latin and Cyrillic
+// Digits, spaces, punctuation and other common runes fit any script.
+// Han may be mixed with Hiragana and Katakana (Japanese), Hangul (Korean) or Bopomofo, as UTS #39 says.
+// Example: HasMixedScripts("pаypal") returns true, since the first "а" is Cyrillic
+func HasMixedScripts(s string) bool {
+	var resolved []string
+
+	for _, r := range s {
+		scripts := runeScripts(r)
+
+		if scripts == nil {
+			continue
+		}
+
+		if resolved == nil {
+			resolved = scripts
+			continue
+		}
+
+		var intersection []string
+
+		for _, a := range resolved {
+			for _, b := range scripts {
+				if a == b {
+					intersection = append(intersection, a)
+				}
+			}
+		}
+
+		if len(intersection) == 0 {
+			return true
+		}
+
+		resolved = intersection
+	}
+
+	return false
+}
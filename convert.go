@@ -1,6 +1,9 @@
 package stringo
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -39,3 +42,243 @@ func AsFloat64(s string, decimalSeparator string) float64 {
 
 	return f
 }
+
+var (
+	// ErrNumberSyntax means a string isn't a number, in the expected notation
+	ErrNumberSyntax = errors.New("invalid number syntax")
+	// ErrNumberRange means a number doesn't fit its type, or is out of the given bounds
+	ErrNumberRange = errors.New("number out of range")
+)
+
+// signedInt is the constraint of signed integer types
+type signedInt interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// unsignedInt is the constraint of unsigned integer types
+type unsignedInt interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// integerType is the constraint of integer types, accepted by ParseInt
+type integerType interface {
+	signedInt | unsignedInt
+}
+
+// floatType is the constraint of floating point types, accepted by ParseFloat
+type floatType interface {
+	~float32 | ~float64
+}
+
+// ParseIntOptions parametrizes ParseInt. The zero value parses decimal numbers only.
+type ParseIntOptions struct {
+	// Prefixes enables hexadecimal, octal and binary numbers, prefixed by "0x", "0o" and "0b". A leading zero alone is still decimal.
+	Prefixes bool
+}
+
+// removeDigitSeparators removes underscores between digits, failing for underscores anywhere else
+func removeDigitSeparators(s string, isDigit func(byte) bool) (string, bool) {
+	if !strings.Contains(s, "_") {
+		return s, true
+	}
+
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '_' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		if i == 0 || i == len(s)-1 || !isDigit(s[i-1]) || !isDigit(s[i+1]) {
+			return "", false
+		}
+	}
+
+	return b.String(), true
+}
+
+// parseIntMagnitude parses the sign and absolute value of an integer
+func parseIntMagnitude(s string, opts ParseIntOptions) (bool, uint64, error) {
+	digits := strings.TrimSpace(s)
+	negative := false
+
+	if digits != "" && (digits[0] == '+' || digits[0] == '-') {
+		negative, digits = digits[0] == '-', digits[1:]
+	}
+
+	base := 10
+
+	if opts.Prefixes && len(digits) > 2 && digits[0] == '0' {
+		switch digits[1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+
+		if base != 10 {
+			digits = digits[2:]
+		}
+	}
+
+	digits, ok := removeDigitSeparators(digits, func(c byte) bool {
+		return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+	})
+
+	if !ok || digits == "" || digits[0] == '+' || digits[0] == '-' {
+		return false, 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	}
+
+	magnitude, err := strconv.ParseUint(digits, base, 64)
+
+	if errors.Is(err, strconv.ErrRange) {
+		return false, 0, fmt.Errorf("%w: %q", ErrNumberRange, s)
+	}
+
+	if err != nil {
+		return false, 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	}
+
+	return negative, magnitude, nil
+}
+
+// ParseInt converts a string to any integer type, failing with ErrNumberSyntax or ErrNumberRange, instead of returning zero as AsInt does
+// Surrounding whitespace, a leading sign and underscores between digits are accepted. Overflow is checked for the type's width.
+// Example: ParseInt[int8](" +1_27 ", ParseIntOptions{}) returns 127
+// Example: ParseInt[uint16]("0xFFFF", ParseIntOptions{Prefixes: true}) returns 65535
+func ParseInt[T integerType](s string, opts ParseIntOptions) (T, error) {
+	negative, magnitude, err := parseIntMagnitude(s, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	var zero T
+
+	// unsigned types wrap around below zero
+	if zero-1 > 0 {
+		v := T(magnitude)
+
+		if negative && magnitude != 0 || uint64(v) != magnitude {
+			return 0, fmt.Errorf("%w: %q", ErrNumberRange, s)
+		}
+
+		return v, nil
+	}
+
+	if magnitude > 1<<63 || magnitude == 1<<63 && !negative {
+		return 0, fmt.Errorf("%w: %q", ErrNumberRange, s)
+	}
+
+	i := int64(magnitude)
+
+	if negative {
+		i = -i
+	}
+
+	v := T(i)
+
+	if int64(v) != i {
+		return 0, fmt.Errorf("%w: %q", ErrNumberRange, s)
+	}
+
+	return v, nil
+}
+
+// ParseIntRange is ParseInt, failing with ErrNumberRange if the number is lower than min or greater than max
+// Example: ParseIntRange[int]("13", 1, 12, ParseIntOptions{}) fails with ErrNumberRange
+func ParseIntRange[T integerType](s string, min, max T, opts ParseIntOptions) (T, error) {
+	v, err := ParseInt[T](s, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	if v < min || v > max {
+		return 0, fmt.Errorf("%w: %q isn't between %d and %d", ErrNumberRange, s, min, max)
+	}
+
+	return v, nil
+}
+
+// ParseIntOrDefault is ParseInt, returning the given default if the string isn't a valid number for the type
+// Example: ParseIntOrDefault("n/a", -1, ParseIntOptions{}) returns -1
+func ParseIntOrDefault[T integerType](s string, def T, opts ParseIntOptions) T {
+	v, err := ParseInt[T](s, opts)
+	if err != nil {
+		return def
+	}
+
+	return v
+}
+
+// ParseFloat converts a string to any floating point type, failing with ErrNumberSyntax or ErrNumberRange, instead of returning zero as AsFloat64 does
+// Surrounding whitespace, a leading sign and underscores between digits are accepted. The decimal separator is a period; see AsFloat64 for others.
+// Infinities, NaN and hexadecimal floats aren't numbers here, and values overflowing or underflowing the type fail with ErrNumberRange.
+// Example: ParseFloat[float32]("1_000.5") returns 1000.5
+func ParseFloat[T floatType](s string) (T, error) {
+	digits, ok := removeDigitSeparators(strings.TrimSpace(s), func(c byte) bool { return c >= '0' && c <= '9' })
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	}
+
+	unsigned := strings.TrimLeft(digits, "+-")
+
+	if strings.HasPrefix(unsigned, "0x") || strings.HasPrefix(unsigned, "0X") {
+		return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	}
+
+	// float32 is parsed as such, so it is rounded once and overflows are reported
+	bitSize, max := 64, math.MaxFloat64
+
+	if math.IsInf(float64(T(max)), 0) {
+		bitSize = 32
+	}
+
+	f, err := strconv.ParseFloat(digits, bitSize)
+
+	switch {
+	case errors.Is(err, strconv.ErrRange):
+		return 0, fmt.Errorf("%w: %q", ErrNumberRange, s)
+	case err != nil || math.IsInf(f, 0) || math.IsNaN(f):
+		return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	}
+
+	// strconv rounds underflows to zero silently, so a zero from non-zero digits is out of range
+	mantissa := unsigned
+
+	if i := strings.IndexAny(mantissa, "eE"); i >= 0 {
+		mantissa = mantissa[:i]
+	}
+
+	if f == 0 && strings.Trim(mantissa, "0.") != "" {
+		return 0, fmt.Errorf("%w: %q", ErrNumberRange, s)
+	}
+
+	return T(f), nil
+}
+
+// ParseFloatRange is ParseFloat, failing with ErrNumberRange if the number is lower than min or greater than max
+func ParseFloatRange[T floatType](s string, min, max T) (T, error) {
+	v, err := ParseFloat[T](s)
+	if err != nil {
+		return 0, err
+	}
+
+	if v < min || v > max {
+		return 0, fmt.Errorf("%w: %q isn't between %v and %v", ErrNumberRange, s, min, max)
+	}
+
+	return v, nil
+}
+
+// ParseFloatOrDefault is ParseFloat, returning the given default if the string isn't a valid number for the type
+func ParseFloatOrDefault[T floatType](s string, def T) T {
+	v, err := ParseFloat[T](s)
+	if err != nil {
+		return def
+	}
+
+	return v
+}
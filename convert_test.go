@@ -0,0 +1,119 @@
+package stringo
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseInt(t *testing.T) {
+	type myInt int16
+
+	tcs := []struct {
+		summary        string
+		fn             func() (interface{}, error)
+		expectedOutput interface{}
+		expectedError  error
+	}{
+		{"int", func() (interface{}, error) { return ParseInt[int]("42", ParseIntOptions{}) }, 42, nil},
+		{"zero isn't garbage", func() (interface{}, error) { return ParseInt[int]("0", ParseIntOptions{}) }, 0, nil},
+		{"garbage", func() (interface{}, error) { return ParseInt[int]("garbage", ParseIntOptions{}) }, 0, ErrNumberSyntax},
+		{"empty", func() (interface{}, error) { return ParseInt[int]("  ", ParseIntOptions{}) }, 0, ErrNumberSyntax},
+		{"whitespace and plus", func() (interface{}, error) { return ParseInt[int](" +42\t", ParseIntOptions{}) }, 42, nil},
+		{"underscores", func() (interface{}, error) { return ParseInt[int64]("1_000_000", ParseIntOptions{}) }, int64(1000000), nil},
+		{"leading underscore", func() (interface{}, error) { return ParseInt[int]("_1", ParseIntOptions{}) }, 0, ErrNumberSyntax},
+		{"double underscore", func() (interface{}, error) { return ParseInt[int]("1__0", ParseIntOptions{}) }, 0, ErrNumberSyntax},
+		{"double sign", func() (interface{}, error) { return ParseInt[int]("+-1", ParseIntOptions{}) }, 0, ErrNumberSyntax},
+		{"int8 max", func() (interface{}, error) { return ParseInt[int8]("127", ParseIntOptions{}) }, int8(127), nil},
+		{"int8 overflow", func() (interface{}, error) { return ParseInt[int8]("128", ParseIntOptions{}) }, int8(0), ErrNumberRange},
+		{"int8 min", func() (interface{}, error) { return ParseInt[int8]("-128", ParseIntOptions{}) }, int8(-128), nil},
+		{"int8 underflow", func() (interface{}, error) { return ParseInt[int8]("-129", ParseIntOptions{}) }, int8(0), ErrNumberRange},
+		{"int16 overflow", func() (interface{}, error) { return ParseInt[int16]("32768", ParseIntOptions{}) }, int16(0), ErrNumberRange},
+		{"int32 overflow", func() (interface{}, error) { return ParseInt[int32]("2147483648", ParseIntOptions{}) }, int32(0), ErrNumberRange},
+		{"int64 min", func() (interface{}, error) { return ParseInt[int64]("-9223372036854775808", ParseIntOptions{}) }, int64(math.MinInt64), nil},
+		{"int64 overflow", func() (interface{}, error) { return ParseInt[int64]("9223372036854775808", ParseIntOptions{}) }, int64(0), ErrNumberRange},
+		{"uint8 overflow", func() (interface{}, error) { return ParseInt[uint8]("256", ParseIntOptions{}) }, uint8(0), ErrNumberRange},
+		{"uint negative", func() (interface{}, error) { return ParseInt[uint]("-1", ParseIntOptions{}) }, uint(0), ErrNumberRange},
+		{"uint negative zero", func() (interface{}, error) { return ParseInt[uint]("-0", ParseIntOptions{}) }, uint(0), nil},
+		{"uint64 max", func() (interface{}, error) { return ParseInt[uint64]("18446744073709551615", ParseIntOptions{}) }, uint64(math.MaxUint64), nil},
+		{"uint64 overflow", func() (interface{}, error) { return ParseInt[uint64]("18446744073709551616", ParseIntOptions{}) }, uint64(0), ErrNumberRange},
+		{"hexadecimal", func() (interface{}, error) { return ParseInt[uint16]("0xFFFF", ParseIntOptions{Prefixes: true}) }, uint16(65535), nil},
+		{"octal", func() (interface{}, error) { return ParseInt[int]("-0o17", ParseIntOptions{Prefixes: true}) }, -15, nil},
+		{"binary with underscores", func() (interface{}, error) { return ParseInt[int]("0b1010_1010", ParseIntOptions{Prefixes: true}) }, 170, nil},
+		{"leading zero is decimal", func() (interface{}, error) { return ParseInt[int]("017", ParseIntOptions{Prefixes: true}) }, 17, nil},
+		{"prefixes disabled", func() (interface{}, error) { return ParseInt[int]("0x10", ParseIntOptions{}) }, 0, ErrNumberSyntax},
+		{"custom type", func() (interface{}, error) { return ParseInt[myInt]("-300", ParseIntOptions{}) }, myInt(-300), nil},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r, err := tc.fn()
+
+			if !errors.Is(err, tc.expectedError) || tc.expectedError == nil && err != nil || r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tExpected: %v, %v \n\tGot: %v, %v", tc.expectedOutput, tc.expectedError, r, err)
+			}
+		})
+	}
+}
+
+func TestParseIntRangeAndDefault(t *testing.T) {
+	if r, err := ParseIntRange[int]("12", 1, 12, ParseIntOptions{}); err != nil || r != 12 {
+		t.Errorf("Test has failed!\n\tExpected: %v, \n\tGot: %v, %v", 12, r, err)
+	}
+
+	if _, err := ParseIntRange[int]("13", 1, 12, ParseIntOptions{}); !errors.Is(err, ErrNumberRange) {
+		t.Errorf("Test has failed!\n\tExpected: %v, \n\tGot: %v", ErrNumberRange, err)
+	}
+
+	if r := ParseIntOrDefault("n/a", -1, ParseIntOptions{}); r != -1 {
+		t.Errorf("Test has failed!\n\tExpected: %v, \n\tGot: %v", -1, r)
+	}
+
+	if r := ParseIntOrDefault[uint8]("300", 7, ParseIntOptions{}); r != 7 {
+		t.Errorf("Test has failed!\n\tExpected: %v, \n\tGot: %v", 7, r)
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		fn             func() (interface{}, error)
+		expectedOutput interface{}
+		expectedError  error
+	}{
+		{"float64", func() (interface{}, error) { return ParseFloat[float64](" -1.5e3 ") }, -1500.0, nil},
+		{"zero", func() (interface{}, error) { return ParseFloat[float64]("0") }, 0.0, nil},
+		{"garbage", func() (interface{}, error) { return ParseFloat[float64]("garbage") }, 0.0, ErrNumberSyntax},
+		{"underscores", func() (interface{}, error) { return ParseFloat[float32]("1_000.5") }, float32(1000.5), nil},
+		{"misplaced underscore", func() (interface{}, error) { return ParseFloat[float64]("1_.5") }, 0.0, ErrNumberSyntax},
+		{"infinity", func() (interface{}, error) { return ParseFloat[float64]("Inf") }, 0.0, ErrNumberSyntax},
+		{"nan", func() (interface{}, error) { return ParseFloat[float64]("NaN") }, 0.0, ErrNumberSyntax},
+		{"float64 overflow", func() (interface{}, error) { return ParseFloat[float64]("1e400") }, 0.0, ErrNumberRange},
+		{"float32 overflow", func() (interface{}, error) { return ParseFloat[float32]("1e40") }, float32(0), ErrNumberRange},
+		{"float32 underflow", func() (interface{}, error) { return ParseFloat[float32]("1e-50") }, float32(0), ErrNumberRange},
+		{"float64 underflow", func() (interface{}, error) { return ParseFloat[float64]("-1e-400") }, 0.0, ErrNumberRange},
+		{"zero with exponent", func() (interface{}, error) { return ParseFloat[float32]("0.0e5") }, float32(0), nil},
+		// just above the midpoint of 1 and the next float32, which float64 rounds to the midpoint itself
+		{"float32 rounded once", func() (interface{}, error) { return ParseFloat[float32]("1.0000000596046447753906250001") }, math.Nextafter32(1, 2), nil},
+		{"hexadecimal", func() (interface{}, error) { return ParseFloat[float64]("0x1p-2") }, 0.0, ErrNumberSyntax},
+		{"negative hexadecimal", func() (interface{}, error) { return ParseFloat[float64]("-0X1p-2") }, 0.0, ErrNumberSyntax},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r, err := tc.fn()
+
+			if !errors.Is(err, tc.expectedError) || tc.expectedError == nil && err != nil || r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tExpected: %v, %v \n\tGot: %v, %v", tc.expectedOutput, tc.expectedError, r, err)
+			}
+		})
+	}
+
+	if _, err := ParseFloatRange("1.5", 0.0, 1.0); !errors.Is(err, ErrNumberRange) {
+		t.Errorf("Test has failed!\n\tExpected: %v, \n\tGot: %v", ErrNumberRange, err)
+	}
+
+	if r := ParseFloatOrDefault("", 2.5); r != 2.5 {
+		t.Errorf("Test has failed!\n\tExpected: %v, \n\tGot: %v", 2.5, r)
+	}
+}
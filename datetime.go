@@ -1,55 +1,783 @@
 package stringo
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
+
+	"golang.org/x/text/language"
 )
 
-// golangDateFormat translate handy's arbitrary date format to Go's eccentric format
-func golangDateTimeFormat(format string) string {
-	if format == "" {
-		return ""
+// ErrDateLayout means a date layout has an unknown, unsupported or unterminated directive
+var ErrDateLayout = errors.New("invalid date layout")
+
+// DateDialect is the notation of a date layout
+type DateDialect uint8
+
+const (
+	// DateDialectHandy is the notation used by DateTimeAsString and DateReformat, case insensitive. Their Dialect variants take any other
+	// yyyy, yy: year; mmmm, mmm, mm, m: month name, abbreviated name, number and unpadded number; dd, d: day;
+	// hh24: hour 00-23; hh, h: hour 01-12 followed by " AM" or " PM"; nn, n: minute; ss, s: second; zzz: milliseconds;
+	// ww, w: weekday name and abbreviated name; tz: zone abbreviation; tzd: zone offset like "-03:00", or "Z" for UTC;
+	// unix, unixms: seconds or milliseconds since the Unix epoch. Text between single quotes is literal, and '' is a quote.
+	// Breaking change: zzz, tz, tzd, unix and unixms used to be printed as they were, so layouts having them as text must now quote it,
+	// like "'tz'". Layouts without them format as before, except that month names no longer have their "n" taken as a minute.
+	DateDialectHandy DateDialect = 0
+	// DateDialectStrftime is the C, Python and Ruby notation, like "%Y-%m-%d %H:%M:%S"
+	DateDialectStrftime DateDialect = 1
+	// DateDialectICU is the Unicode LDML notation, used by ICU, Java and C#, like "yyyy-MM-dd HH:mm:ss.SSS XXX"
+	DateDialectICU DateDialect = 2
+	// DateDialectMoment is the Moment.js and Day.js notation, like "YYYY-MM-DD HH:mm:ss", with literals in [brackets]
+	DateDialectMoment DateDialect = 3
+)
+
+// dateField is the kind of a date layout part
+type dateField uint8
+
+const (
+	dateLiteral dateField = iota
+	// dateYear width is 4 or 2
+	dateYear
+	// dateMonth width is 1 or 2 for numbers, 3 for the abbreviated name and 4 for the full name
+	dateMonth
+	// dateDay width is 1 or 2, padded with zero
+	dateDay
+	// dateDaySpace is the day padded with space
+	dateDaySpace
+	// dateYearDay width is 1 or 3, padded with zero
+	dateYearDay
+	// dateWeekday width is 3 for the abbreviated name and 4 for the full name
+	dateWeekday
+	dateHour24
+	dateHour12
+	// dateMeridiem width is 2 for "PM" and 1 for "pm"
+	dateMeridiem
+	dateMinute
+	dateSecond
+	// dateFraction width is the count of digits
+	dateFraction
+	dateZoneName
+	// dateZoneOffset width is 1 for "-07", 2 for "-0700" and 3 for "-07:00"
+	dateZoneOffset
+	// dateZoneISO is the same as dateZoneOffset, but with "Z" for UTC
+	dateZoneISO
+	dateUnix
+	dateUnixMilli
+)
+
+// datePart is a directive or literal text of a date layout
+type datePart struct {
+	field   dateField
+	width   int
+	literal string
+}
+
+// dateToken maps a directive of a dialect to a date part
+type dateToken struct {
+	directive string
+	part      datePart
+}
+
+// dateHandyTokens are the DateDialectHandy directives, longest first, so they match greedily
+var dateHandyTokens = []dateToken{
+	{"unixms", datePart{field: dateUnixMilli}},
+	{"unix", datePart{field: dateUnix}},
+	{"yyyy", datePart{field: dateYear, width: 4}},
+	{"mmmm", datePart{field: dateMonth, width: 4}},
+	{"hh24", datePart{field: dateHour24, width: 2}},
+	{"mmm", datePart{field: dateMonth, width: 3}},
+	{"zzz", datePart{field: dateFraction, width: 3}},
+	{"tzd", datePart{field: dateZoneISO, width: 3}},
+	{"yy", datePart{field: dateYear, width: 2}},
+	{"mm", datePart{field: dateMonth, width: 2}},
+	{"dd", datePart{field: dateDay, width: 2}},
+	{"hh", datePart{field: dateHour12, width: 2}},
+	{"nn", datePart{field: dateMinute, width: 2}},
+	{"ss", datePart{field: dateSecond, width: 2}},
+	{"ww", datePart{field: dateWeekday, width: 4}},
+	{"tz", datePart{field: dateZoneName}},
+	{"m", datePart{field: dateMonth, width: 1}},
+	{"d", datePart{field: dateDay, width: 1}},
+	{"h", datePart{field: dateHour12, width: 1}},
+	{"n", datePart{field: dateMinute, width: 1}},
+	{"s", datePart{field: dateSecond, width: 1}},
+	{"w", datePart{field: dateWeekday, width: 3}},
+}
+
+// dateStrftimeTokens are the DateDialectStrftime conversions, without the %
+// The - flag, as in %-d, removes padding. Composite conversions, like %F, are expanded before lookup.
+var dateStrftimeTokens = map[string]datePart{
+	"Y":  {field: dateYear, width: 4},
+	"y":  {field: dateYear, width: 2},
+	"m":  {field: dateMonth, width: 2},
+	"-m": {field: dateMonth, width: 1},
+	"b":  {field: dateMonth, width: 3},
+	"h":  {field: dateMonth, width: 3},
+	"B":  {field: dateMonth, width: 4},
+	"d":  {field: dateDay, width: 2},
+	"-d": {field: dateDay, width: 1},
+	"e":  {field: dateDaySpace, width: 2},
+	"j":  {field: dateYearDay, width: 3},
+	"-j": {field: dateYearDay, width: 1},
+	"a":  {field: dateWeekday, width: 3},
+	"A":  {field: dateWeekday, width: 4},
+	"H":  {field: dateHour24, width: 2},
+	"-H": {field: dateHour24, width: 1},
+	"I":  {field: dateHour12, width: 2},
+	"-I": {field: dateHour12, width: 1},
+	"p":  {field: dateMeridiem, width: 2},
+	"P":  {field: dateMeridiem, width: 1},
+	"M":  {field: dateMinute, width: 2},
+	"-M": {field: dateMinute, width: 1},
+	"S":  {field: dateSecond, width: 2},
+	"-S": {field: dateSecond, width: 1},
+	"L":  {field: dateFraction, width: 3},
+	"f":  {field: dateFraction, width: 6},
+	"3N": {field: dateFraction, width: 3},
+	"6N": {field: dateFraction, width: 6},
+	"9N": {field: dateFraction, width: 9},
+	"N":  {field: dateFraction, width: 9},
+	"z":  {field: dateZoneOffset, width: 2},
+	":z": {field: dateZoneOffset, width: 3},
+	"Z":  {field: dateZoneName},
+	"s":  {field: dateUnix},
+	"%":  {literal: "%"},
+	"n":  {literal: "\n"},
+	"t":  {literal: "\t"},
+}
+
+// dateStrftimeComposites are the strftime conversions made of others
+var dateStrftimeComposites = map[string]string{
+	"F": "%Y-%m-%d",
+	"T": "%H:%M:%S",
+	"D": "%m/%d/%y",
+	"R": "%H:%M",
+	"r": "%I:%M:%S %p",
+}
+
+// dateMomentTokens are the DateDialectMoment tokens, longest first. Fraction tokens, runs of S, are handled apart.
+var dateMomentTokens = []dateToken{
+	{"YYYY", datePart{field: dateYear, width: 4}},
+	{"MMMM", datePart{field: dateMonth, width: 4}},
+	{"DDDD", datePart{field: dateYearDay, width: 3}},
+	{"dddd", datePart{field: dateWeekday, width: 4}},
+	{"MMM", datePart{field: dateMonth, width: 3}},
+	{"DDD", datePart{field: dateYearDay, width: 1}},
+	{"ddd", datePart{field: dateWeekday, width: 3}},
+	{"YY", datePart{field: dateYear, width: 2}},
+	{"MM", datePart{field: dateMonth, width: 2}},
+	{"DD", datePart{field: dateDay, width: 2}},
+	{"HH", datePart{field: dateHour24, width: 2}},
+	{"hh", datePart{field: dateHour12, width: 2}},
+	{"mm", datePart{field: dateMinute, width: 2}},
+	{"ss", datePart{field: dateSecond, width: 2}},
+	{"ZZ", datePart{field: dateZoneOffset, width: 2}},
+	{"zz", datePart{field: dateZoneName}},
+	{"M", datePart{field: dateMonth, width: 1}},
+	{"D", datePart{field: dateDay, width: 1}},
+	{"H", datePart{field: dateHour24, width: 1}},
+	{"h", datePart{field: dateHour12, width: 1}},
+	{"m", datePart{field: dateMinute, width: 1}},
+	{"s", datePart{field: dateSecond, width: 1}},
+	{"A", datePart{field: dateMeridiem, width: 2}},
+	{"a", datePart{field: dateMeridiem, width: 1}},
+	{"Z", datePart{field: dateZoneOffset, width: 3}},
+	{"z", datePart{field: dateZoneName}},
+	{"X", datePart{field: dateUnix}},
+	{"x", datePart{field: dateUnixMilli}},
+}
+
+// dateMomentUnsupported are Moment tokens with no equivalent here, like ordinals and week numbers
+var dateMomentUnsupported = []string{"Do", "do", "dd", "d", "Q", "W", "w", "E", "e", "k", "N", "G", "g"}
+
+// appendDateLiteral appends literal text to the parts, merging it with a preceding literal
+func appendDateLiteral(parts []datePart, literal string) []datePart {
+	if literal == "" {
+		return parts
+	}
+
+	if n := len(parts); n > 0 && parts[n-1].field == dateLiteral {
+		parts[n-1].literal += literal
+		return parts
 	}
 
-	newFormat := strings.ToLower(format)
+	return append(parts, datePart{literal: literal})
+}
 
-	newFormat = strings.Replace(newFormat, "yyyy", "2006", -1)
-	newFormat = strings.Replace(newFormat, "yy", "06", -1)
-	newFormat = strings.Replace(newFormat, "mmmm", "January", -1)
-	newFormat = strings.Replace(newFormat, "mmm", "Jan", -1)
-	newFormat = strings.Replace(newFormat, "mm", "01", -1)
-	newFormat = strings.Replace(newFormat, "m", "1", -1)
-	newFormat = strings.Replace(newFormat, "dd", "02", -1)
-	newFormat = strings.Replace(newFormat, "d", "2", -1)
-	newFormat = strings.Replace(newFormat, "hh24", "15", -1)
-	newFormat = strings.Replace(newFormat, "hh", "03 PM", -1)
-	newFormat = strings.Replace(newFormat, "h", "3 PM", -1)
-	newFormat = strings.Replace(newFormat, "nn", "04", -1)
-	newFormat = strings.Replace(newFormat, "n", "4", -1)
-	newFormat = strings.Replace(newFormat, "ss", "05", -1)
-	newFormat = strings.Replace(newFormat, "s", "5", -1)
-	newFormat = strings.Replace(newFormat, "ww", "Monday", -1)
-	newFormat = strings.Replace(newFormat, "w", "Mon", -1)
+// appendDatePart appends a directive or literal to the parts
+func appendDatePart(parts []datePart, p datePart) []datePart {
+	if p.field == dateLiteral {
+		return appendDateLiteral(parts, p.literal)
+	}
 
-	return newFormat
+	return append(parts, p)
 }
 
-// DateTimeAsString formats time.Time variables as strings, considering the format directive
+// dateQuoted reads the text quoted by q, starting after the opening quote, where a doubled quote is a literal one
+// It returns the unquoted text and the position after the closing quote.
+func dateQuoted(layout string, start int, q byte, doubled bool) (string, int, error) {
+	var b strings.Builder
+
+	for i := start; i < len(layout); i++ {
+		if layout[i] != q {
+			b.WriteByte(layout[i])
+			continue
+		}
+
+		if doubled && i+1 < len(layout) && layout[i+1] == q {
+			b.WriteByte(q)
+			i++
+
+			continue
+		}
+
+		return b.String(), i + 1, nil
+	}
+
+	return "", 0, fmt.Errorf("%w: unterminated literal at %d", ErrDateLayout, start-1)
+}
+
+// parseDateLayout splits a layout, in the given dialect, into parts
+func parseDateLayout(layout string, dialect DateDialect) ([]datePart, error) {
+	switch dialect {
+	case DateDialectHandy:
+		return parseDateHandy(layout)
+	case DateDialectStrftime:
+		return parseDateStrftime(layout)
+	case DateDialectICU:
+		return parseDateICU(layout)
+	case DateDialectMoment:
+		return parseDateMoment(layout)
+	}
+
+	return nil, fmt.Errorf("%w: unknown dialect %d", ErrDateLayout, dialect)
+}
+
+// parseDateHandy splits a DateDialectHandy layout into parts
+func parseDateHandy(layout string) ([]datePart, error) {
+	var parts []datePart
+
+	lower := strings.ToLower(layout)
+
+outer:
+	for i := 0; i < len(layout); {
+		if layout[i] == '\'' {
+			if i+1 < len(layout) && layout[i+1] == '\'' {
+				parts = appendDateLiteral(parts, "'")
+				i += 2
+
+				continue
+			}
+
+			literal, next, err := dateQuoted(layout, i+1, '\'', true)
+			if err != nil {
+				return nil, err
+			}
+
+			parts = appendDateLiteral(parts, literal)
+			i = next
+
+			continue
+		}
+
+		for _, tk := range dateHandyTokens {
+			if strings.HasPrefix(lower[i:], tk.directive) {
+				parts = append(parts, tk.part)
+
+				// 12 hours clock always had the meridiem appended
+				if tk.part.field == dateHour12 {
+					parts = appendDateLiteral(parts, " ")
+					parts = append(parts, datePart{field: dateMeridiem, width: 2})
+				}
+
+				i += len(tk.directive)
+
+				continue outer
+			}
+		}
+
+		parts = appendDateLiteral(parts, layout[i:i+1])
+		i++
+	}
+
+	return parts, nil
+}
+
+// parseDateStrftime splits a DateDialectStrftime layout into parts
+func parseDateStrftime(layout string) ([]datePart, error) {
+	var parts []datePart
+
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			parts = appendDateLiteral(parts, layout[i:i+1])
+			continue
+		}
+
+		// the conversion is the longest one among "-x", ":x", "3N" and "x"
+		conversion := ""
+
+		for _, n := range []int{2, 1} {
+			if i+1+n <= len(layout) {
+				c := layout[i+1 : i+1+n]
+
+				if _, ok := dateStrftimeTokens[c]; ok {
+					conversion = c
+					break
+				}
+
+				if _, ok := dateStrftimeComposites[c]; ok {
+					conversion = c
+					break
+				}
+			}
+		}
+
+		if conversion == "" {
+			return nil, fmt.Errorf("%w: unsupported conversion at %d", ErrDateLayout, i)
+		}
+
+		if composite, ok := dateStrftimeComposites[conversion]; ok {
+			sub, _ := parseDateStrftime(composite)
+
+			for _, p := range sub {
+				parts = appendDatePart(parts, p)
+			}
+		} else {
+			parts = appendDatePart(parts, dateStrftimeTokens[conversion])
+		}
+
+		i += len(conversion)
+	}
+
+	return parts, nil
+}
+
+// parseDateICU splits a DateDialectICU layout into parts. Every ASCII letter is a pattern letter, unless quoted.
+func parseDateICU(layout string) ([]datePart, error) {
+	var parts []datePart
+
+	for i := 0; i < len(layout); {
+		c := layout[i]
+
+		if c == '\'' {
+			if i+1 < len(layout) && layout[i+1] == '\'' {
+				parts = appendDateLiteral(parts, "'")
+				i += 2
+
+				continue
+			}
+
+			literal, next, err := dateQuoted(layout, i+1, '\'', true)
+			if err != nil {
+				return nil, err
+			}
+
+			parts = appendDateLiteral(parts, literal)
+			i = next
+
+			continue
+		}
+
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			parts = appendDateLiteral(parts, layout[i:i+1])
+			i++
+
+			continue
+		}
+
+		n := 1
+
+		for i+n < len(layout) && layout[i+n] == c {
+			n++
+		}
+
+		p, ok := dateICUPart(c, n)
+		if !ok {
+			return nil, fmt.Errorf("%w: unsupported pattern %q at %d", ErrDateLayout, layout[i:i+n], i)
+		}
+
+		parts = append(parts, p)
+		i += n
+	}
+
+	return parts, nil
+}
+
+// dateICUPart returns the date part for n repetitions of an ICU pattern letter
+func dateICUPart(c byte, n int) (datePart, bool) {
+	switch {
+	case c == 'y' || c == 'u':
+		if n == 2 {
+			return datePart{field: dateYear, width: 2}, true
+		}
+
+		return datePart{field: dateYear, width: 4}, true
+	case (c == 'M' || c == 'L') && n <= 4:
+		return datePart{field: dateMonth, width: n}, true
+	case c == 'd' && n <= 2:
+		return datePart{field: dateDay, width: n}, true
+	case c == 'D' && n <= 3:
+		if n == 1 {
+			return datePart{field: dateYearDay, width: 1}, true
+		}
+
+		return datePart{field: dateYearDay, width: 3}, true
+	case c == 'E' || c == 'e' && n >= 3 || c == 'c' && n >= 3:
+		if n == 4 {
+			return datePart{field: dateWeekday, width: 4}, true
+		}
+
+		if n <= 3 {
+			return datePart{field: dateWeekday, width: 3}, true
+		}
+	case c == 'a' && n <= 3:
+		return datePart{field: dateMeridiem, width: 2}, true
+	case c == 'H' && n <= 2:
+		return datePart{field: dateHour24, width: n}, true
+	case c == 'h' && n <= 2:
+		return datePart{field: dateHour12, width: n}, true
+	case c == 'm' && n <= 2:
+		return datePart{field: dateMinute, width: n}, true
+	case c == 's' && n <= 2:
+		return datePart{field: dateSecond, width: n}, true
+	case c == 'S' && n <= 9:
+		return datePart{field: dateFraction, width: n}, true
+	case c == 'z' && n <= 3:
+		return datePart{field: dateZoneName}, true
+	case c == 'Z' && n <= 3:
+		return datePart{field: dateZoneOffset, width: 2}, true
+	case c == 'Z' && n == 5:
+		return datePart{field: dateZoneISO, width: 3}, true
+	case c == 'X' && n <= 3:
+		return datePart{field: dateZoneISO, width: n}, true
+	case c == 'x' && n <= 3:
+		return datePart{field: dateZoneOffset, width: n}, true
+	}
+
+	return datePart{}, false
+}
+
+// parseDateMoment splits a DateDialectMoment layout into parts. Unknown characters are literal, as in Moment.
+func parseDateMoment(layout string) ([]datePart, error) {
+	var parts []datePart
+
+	for i := 0; i < len(layout); {
+		if layout[i] == '[' {
+			literal, next, err := dateQuoted(layout, i+1, ']', false)
+			if err != nil {
+				return nil, err
+			}
+
+			parts = appendDateLiteral(parts, literal)
+			i = next
+
+			continue
+		}
+
+		if layout[i] == 'S' {
+			n := 1
+
+			for i+n < len(layout) && layout[i+n] == 'S' {
+				n++
+			}
+
+			if n > 9 {
+				return nil, fmt.Errorf("%w: fraction longer than nanoseconds at %d", ErrDateLayout, i)
+			}
+
+			parts = append(parts, datePart{field: dateFraction, width: n})
+			i += n
+
+			continue
+		}
+
+		// the longest token wins, so "Do" is an unsupported ordinal and "dddd" a weekday
+		var matched *dateToken
+
+		for k := range dateMomentTokens {
+			if strings.HasPrefix(layout[i:], dateMomentTokens[k].directive) {
+				matched = &dateMomentTokens[k]
+				break
+			}
+		}
+
+		for _, unsupported := range dateMomentUnsupported {
+			if (matched == nil || len(unsupported) > len(matched.directive)) && strings.HasPrefix(layout[i:], unsupported) {
+				return nil, fmt.Errorf("%w: unsupported token %q at %d", ErrDateLayout, unsupported, i)
+			}
+		}
+
+		if matched != nil {
+			parts = append(parts, matched.part)
+			i += len(matched.directive)
+
+			continue
+		}
+
+		parts = appendDateLiteral(parts, layout[i:i+1])
+		i++
+	}
+
+	return parts, nil
+}
+
+// datePad formats the number padded with zeros to the given width
+func datePad(n, width int) string {
+	s := strconv.Itoa(n)
+
+	for len(s) < width {
+		s = "0" + s
+	}
+
+	return s
+}
+
+// dateOffset formats a zone offset in seconds as "-07", "-0700" or "-07:00", according the width
+func dateOffset(offset, width int) string {
+	sign := "+"
+
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	hours, minutes := datePad(offset/3600, 2), datePad(offset%3600/60, 2)
+
+	switch width {
+	case 1:
+		return sign + hours
+	case 2:
+		return sign + hours + minutes
+	}
+
+	return sign + hours + ":" + minutes
+}
+
+// formatDateParts formats the time according the given parts, with the given month and weekday names
+func formatDateParts(t time.Time, parts []datePart, names *dateNames) string {
+	var b strings.Builder
+
+	for _, p := range parts {
+		switch p.field {
+		case dateLiteral:
+			b.WriteString(p.literal)
+		case dateYear:
+			if p.width == 2 {
+				b.WriteString(datePad(t.Year()%100, 2))
+			} else {
+				b.WriteString(datePad(t.Year(), 4))
+			}
+		case dateMonth:
+			switch p.width {
+			case 3, 4:
+				b.WriteString(names.month(t.Month(), p.width == 3))
+			default:
+				b.WriteString(datePad(int(t.Month()), p.width))
+			}
+		case dateDay:
+			b.WriteString(datePad(t.Day(), p.width))
+		case dateDaySpace:
+			b.WriteString(fmt.Sprintf("%2d", t.Day()))
+		case dateYearDay:
+			b.WriteString(datePad(t.YearDay(), p.width))
+		case dateWeekday:
+			b.WriteString(names.weekday(t.Weekday(), p.width == 3))
+		case dateHour24:
+			b.WriteString(datePad(t.Hour(), p.width))
+		case dateHour12:
+			h := t.Hour() % 12
+
+			if h == 0 {
+				h = 12
+			}
+
+			b.WriteString(datePad(h, p.width))
+		case dateMeridiem:
+			m := names.meridiem(t.Hour())
+
+			if p.width == 1 {
+				m = strings.ToLower(m)
+			}
+
+			b.WriteString(m)
+		case dateMinute:
+			b.WriteString(datePad(t.Minute(), p.width))
+		case dateSecond:
+			b.WriteString(datePad(t.Second(), p.width))
+		case dateFraction:
+			b.WriteString(datePad(t.Nanosecond(), 9)[:p.width])
+		case dateZoneName:
+			name, _ := t.Zone()
+			b.WriteString(name)
+		case dateZoneOffset, dateZoneISO:
+			_, offset := t.Zone()
+
+			if p.field == dateZoneISO && offset == 0 {
+				b.WriteString("Z")
+			} else {
+				b.WriteString(dateOffset(offset, p.width))
+			}
+		case dateUnix:
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case dateUnixMilli:
+			b.WriteString(strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10))
+		}
+	}
+
+	return b.String()
+}
+
+// dateGoReserved are the substrings Go's time package would take as directives inside literal text
+var dateGoReserved = []string{"0", "1", "2", "3", "4", "5", "Jan", "Mon", "MST", "PM", "pm", "-07", "Z07", "_2", ".9", ",9"}
+
+// goDateParts returns the Go layout equivalent to the given parts
+func goDateParts(parts []datePart) (string, error) {
+	var b strings.Builder
+
+	for i, p := range parts {
+		switch p.field {
+		case dateLiteral:
+			for _, reserved := range dateGoReserved {
+				if strings.Contains(p.literal, reserved) {
+					return "", fmt.Errorf("%w: literal %q can't be expressed as a Go layout", ErrDateLayout, p.literal)
+				}
+			}
+
+			b.WriteString(p.literal)
+		case dateYear:
+			if p.width == 2 {
+				b.WriteString("06")
+			} else {
+				b.WriteString("2006")
+			}
+		case dateMonth:
+			b.WriteString([]string{"", "1", "01", "Jan", "January"}[p.width])
+		case dateDay:
+			b.WriteString([]string{"", "2", "02"}[p.width])
+		case dateDaySpace:
+			b.WriteString("_2")
+		case dateYearDay:
+			if p.width != 3 {
+				return "", fmt.Errorf("%w: unpadded day of year can't be expressed as a Go layout", ErrDateLayout)
+			}
+
+			b.WriteString("002")
+		case dateWeekday:
+			if p.width == 3 {
+				b.WriteString("Mon")
+			} else {
+				b.WriteString("Monday")
+			}
+		case dateHour24:
+			if p.width != 2 {
+				return "", fmt.Errorf("%w: unpadded 24 hours can't be expressed as a Go layout", ErrDateLayout)
+			}
+
+			b.WriteString("15")
+		case dateHour12:
+			b.WriteString([]string{"", "3", "03"}[p.width])
+		case dateMeridiem:
+			if p.width == 1 {
+				b.WriteString("pm")
+			} else {
+				b.WriteString("PM")
+			}
+		case dateMinute:
+			b.WriteString([]string{"", "4", "04"}[p.width])
+		case dateSecond:
+			b.WriteString([]string{"", "5", "05"}[p.width])
+		case dateFraction:
+			if i == 0 || parts[i-1].field != dateLiteral || !strings.HasSuffix(parts[i-1].literal, ".") && !strings.HasSuffix(parts[i-1].literal, ",") {
+				return "", fmt.Errorf("%w: fractions must follow a period or comma in a Go layout", ErrDateLayout)
+			}
+
+			b.WriteString(strings.Repeat("0", p.width))
+		case dateZoneName:
+			b.WriteString("MST")
+		case dateZoneOffset:
+			b.WriteString([]string{"", "-07", "-0700", "-07:00"}[p.width])
+		case dateZoneISO:
+			b.WriteString([]string{"", "Z07", "Z0700", "Z07:00"}[p.width])
+		case dateUnix, dateUnixMilli:
+			return "", fmt.Errorf("%w: Unix timestamps can't be expressed as a Go layout", ErrDateLayout)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// GoDateLayout translates a layout, in the given dialect, to Go's reference time notation, for use with time.Format and time.Parse
+// It fails for directives Go has no equivalent to, like Unix timestamps, and for literal text Go would take as directives.
+// Example: GoDateLayout("yyyy-MM-dd'T'HH:mm:ss.SSSXXX", DateDialectICU) returns "2006-01-02T15:04:05.000Z07:00"
+func GoDateLayout(layout string, dialect DateDialect) (string, error) {
+	parts, err := parseDateLayout(layout, dialect)
+	if err != nil {
+		return "", err
+	}
+
+	return goDateParts(parts)
+}
+
+// FormatDateTime formats the time according a layout in the given dialect
+// Example: FormatDateTime(t, "%d/%m/%Y %H:%M", DateDialectStrftime) returns "17/10/2026 14:05"
+func FormatDateTime(t time.Time, layout string, dialect DateDialect) (string, error) {
+	parts, err := parseDateLayout(layout, dialect)
+	if err != nil {
+		return "", err
+	}
+
+	return formatDateParts(t, parts, dateNamesEnglish), nil
+}
+
+// DateTimeAsString formats time.Time variables as strings, considering the format directive, in DateDialectHandy notation
+// Example: DateTimeAsString(t, "dd/mm/yyyy hh24:nn") returns "17/10/2026 14:05"
 func DateTimeAsString(dt time.Time, format string) string {
-	newFormat := golangDateTimeFormat(format)
+	// handy layouts never fail: unknown characters are literal, and only an unterminated quote is an error
+	s, err := FormatDateTime(dt, format, DateDialectHandy)
+	if err != nil {
+		return ""
+	}
 
-	return dt.Format(newFormat)
+	return s
 }
 
-// DateReformat gets a date string in a given currentFormat, and transform it according newFormat
-func DateReformat(d string, currentLayout, newLayout string) string {
-	currentLayout = golangDateTimeFormat(currentLayout)
+// DateTimeAsStringDialect is DateTimeAsString with the format in the given dialect. It returns an empty string if the format is invalid.
+// Example: DateTimeAsStringDialect(t, "dd/MM/yyyy HH:mm", DateDialectICU) returns "17/10/2026 14:05"
+func DateTimeAsStringDialect(dt time.Time, format string, dialect DateDialect) string {
+	s, err := FormatDateTime(dt, format, dialect)
+	if err != nil {
+		return ""
+	}
+
+	return s
+}
 
-	if t, err := time.Parse(currentLayout, d); err != nil || t.IsZero() {
+// dateReformat parses the date according the current layout and formats it according the new one, both in the given dialect
+func dateReformat(d string, currentLayout, newLayout string, dialect DateDialect, lang language.Tag) string {
+	next, err := parseDateLayout(newLayout, dialect)
+	if err != nil {
 		return ""
-	} else {
-		newLayout = golangDateTimeFormat(newLayout)
+	}
 
-		return t.Format(newLayout)
+	t, err := ParseDate(d, currentLayout, ParseDateOptions{Dialect: dialect, Language: lang})
+	if err != nil || t.IsZero() {
+		return ""
 	}
+
+	return formatDateParts(t, next, dateNamesFor(lang))
+}
+
+// DateReformat gets a date string in a given currentFormat, and transform it according newFormat
+// Both layouts are in DateDialectHandy notation. It returns an empty string if the date can't be parsed; use ParseDate to know why.
+// Example: DateReformat("2026-10-17", "yyyy-mm-dd", "dd/mm/yyyy") returns "17/10/2026"
+func DateReformat(d string, currentLayout, newLayout string) string {
+	return dateReformat(d, currentLayout, newLayout, DateDialectHandy, language.English)
+}
+
+// DateReformatDialect is DateReformat with both layouts in the given dialect
+// Example: DateReformatDialect("2026-10-17", "%Y-%m-%d", "%d/%m/%Y", DateDialectStrftime) returns "17/10/2026"
+func DateReformatDialect(d string, currentLayout, newLayout string, dialect DateDialect) string {
+	return dateReformat(d, currentLayout, newLayout, dialect, language.English)
 }
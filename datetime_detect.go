@@ -0,0 +1,116 @@
+package stringo
+
+import (
+	"strings"
+	"time"
+)
+
+// dateCandidate is a layout DetectDateLayout tries, and the layout with day and month swapped, if any
+type dateCandidate struct {
+	layout  string
+	swapped string
+}
+
+// dateCandidates are the layouts DetectDateLayout tries, in DateDialectHandy notation, most likely first
+// Day first layouts come before month first ones, so they win when every sample is ambiguous. Layouts with milliseconds come before
+// the ones without, which match fractional seconds of any length too, so they win only when every sample has exactly 3 digits.
+var dateCandidates = []dateCandidate{
+	{"yyyy-mm-dd'T'hh24:nn:ss.zzztzd", ""},
+	{"yyyy-mm-dd'T'hh24:nn:sstzd", ""},
+	{"yyyy-mm-dd'T'hh24:nn:ss.zzz", ""},
+	{"yyyy-mm-dd'T'hh24:nn:ss", ""},
+	{"yyyy-mm-dd hh24:nn:ss", ""},
+	{"yyyy-mm-dd hh24:nn", ""},
+	{"yyyy-mm-dd", ""},
+	{"yyyy/mm/dd", ""},
+	{"yyyymmdd", ""},
+	{"dd/mm/yyyy hh24:nn:ss", "mm/dd/yyyy hh24:nn:ss"},
+	{"mm/dd/yyyy hh24:nn:ss", "dd/mm/yyyy hh24:nn:ss"},
+	{"dd/mm/yyyy hh24:nn", "mm/dd/yyyy hh24:nn"},
+	{"mm/dd/yyyy hh24:nn", "dd/mm/yyyy hh24:nn"},
+	{"dd/mm/yyyy", "mm/dd/yyyy"},
+	{"mm/dd/yyyy", "dd/mm/yyyy"},
+	{"d/m/yyyy", "m/d/yyyy"},
+	{"m/d/yyyy", "d/m/yyyy"},
+	{"dd-mm-yyyy", "mm-dd-yyyy"},
+	{"mm-dd-yyyy", "dd-mm-yyyy"},
+	{"dd.mm.yyyy", "mm.dd.yyyy"},
+	{"mm.dd.yyyy", "dd.mm.yyyy"},
+	{"dd/mm/yy", "mm/dd/yy"},
+	{"mm/dd/yy", "dd/mm/yy"},
+	{"d/m/yy", "m/d/yy"},
+	{"m/d/yy", "d/m/yy"},
+	{"d mmm yyyy", ""},
+	{"d mmmm yyyy", ""},
+	{"mmm d, yyyy", ""},
+	{"mmmm d, yyyy", ""},
+	{"unix", ""},
+	{"unixms", ""},
+}
+
+// dateUnixPlausible tells if a sample parsed as a Unix timestamp is likely one, and not some other number, like "42" or "2026"
+// Timestamps have 9 or 10 digits in seconds, 12 or 13 in milliseconds, and are between 1970 and 2100.
+func dateUnixPlausible(sample string, field dateField, t time.Time) bool {
+	digits := len(strings.TrimLeft(sample, "+-"))
+	min, max := 9, 10
+
+	if field == dateUnixMilli {
+		min, max = 12, 13
+	}
+
+	return digits >= min && digits <= max && t.Year() >= 1970 && t.Year() <= 2100
+}
+
+// DetectDateLayout returns the layout, in DateDialectHandy notation, matching most of the sample dates, and a confidence from 0 to 1
+// It tries ISO 8601 and RFC 3339, day and month first layouts with several separators, compact yyyymmdd,
+// English month names, and Unix timestamps of 9 or 10 digits in seconds, or 12 or 13 in milliseconds. Blank samples are ignored.
+// The confidence is the ratio of samples matching the layout, halved if no sample tells day first from month first.
+// It returns an empty layout, and zero, if no layout matches any sample.
+// Example: DetectDateLayout([]string{"03/04/2026", "25/12/2026"}) returns "dd/mm/yyyy", 1
+func DetectDateLayout(samples []string) (string, float64) {
+	var values []string
+
+	for _, s := range samples {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+
+	if len(values) == 0 {
+		return "", 0
+	}
+
+	matches := make(map[string]int, len(dateCandidates))
+	best := -1
+	p := newDateParser(ParseDateOptions{})
+
+	for i, c := range dateCandidates {
+		parts, _ := parseDateLayout(c.layout, DateDialectHandy)
+
+		for _, v := range values {
+			t, err := p.parse(v, c.layout, parts)
+
+			if err == nil && (parts[0].field != dateUnix && parts[0].field != dateUnixMilli || dateUnixPlausible(v, parts[0].field, t)) {
+				matches[c.layout]++
+			}
+		}
+
+		// ties go to the earlier, more likely, layout
+		if matches[c.layout] > 0 && (best < 0 || matches[c.layout] > matches[dateCandidates[best].layout]) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		return "", 0
+	}
+
+	c := dateCandidates[best]
+	confidence := float64(matches[c.layout]) / float64(len(values))
+
+	if c.swapped != "" && matches[c.swapped] == matches[c.layout] {
+		confidence /= 2
+	}
+
+	return c.layout, confidence
+}
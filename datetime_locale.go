@@ -0,0 +1,139 @@
+package stringo
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// dateNames are the month and weekday names, and meridiem markers, of a language
+type dateNames struct {
+	months        [12]string
+	monthsShort   [12]string
+	weekdays      [7]string
+	weekdaysShort [7]string
+	am            string
+	pm            string
+}
+
+// month returns the full or abbreviated name of the month
+func (n *dateNames) month(m time.Month, short bool) string {
+	if short {
+		return n.monthsShort[m-1]
+	}
+
+	return n.months[m-1]
+}
+
+// weekday returns the full or abbreviated name of the weekday
+func (n *dateNames) weekday(d time.Weekday, short bool) string {
+	if short {
+		return n.weekdaysShort[d]
+	}
+
+	return n.weekdays[d]
+}
+
+// meridiem returns the AM or PM marker for the hour
+func (n *dateNames) meridiem(hour int) string {
+	if hour >= 12 {
+		return n.pm
+	}
+
+	return n.am
+}
+
+// dateNamesEnglish are the English names, the same used by Go's time package
+var dateNamesEnglish = &dateNames{
+	months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	monthsShort:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	weekdaysShort: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	am:            "AM",
+	pm:            "PM",
+}
+
+// dateLocales are the date names by language base, according CLDR, in the case used inside sentences
+var dateLocales = map[string]*dateNames{
+	"en": dateNamesEnglish,
+	"pt": {
+		months:        [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		monthsShort:   [12]string{"jan", "fev", "mar", "abr", "mai", "jun", "jul", "ago", "set", "out", "nov", "dez"},
+		weekdays:      [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		weekdaysShort: [7]string{"dom", "seg", "ter", "qua", "qui", "sex", "sáb"},
+		am:            "AM",
+		pm:            "PM",
+	},
+	"es": {
+		months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		monthsShort:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		weekdaysShort: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		am:            "a. m.",
+		pm:            "p. m.",
+	},
+	"fr": {
+		months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		monthsShort:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		weekdaysShort: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		am:            "AM",
+		pm:            "PM",
+	},
+	"de": {
+		months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		monthsShort:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		weekdaysShort: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		am:            "AM",
+		pm:            "PM",
+	},
+	"it": {
+		months:        [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		monthsShort:   [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		weekdays:      [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		weekdaysShort: [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		am:            "AM",
+		pm:            "PM",
+	},
+}
+
+// dateNamesFor returns the date names for the language, falling back to English
+func dateNamesFor(lang language.Tag) *dateNames {
+	base, _ := lang.Base()
+
+	if n, ok := dateLocales[base.String()]; ok {
+		return n
+	}
+
+	return dateNamesEnglish
+}
+
+// FormatDateTimeLocale formats the time according a layout in the given dialect, with month and weekday names in the given language
+// Supported languages are English, Portuguese, Spanish, French, German and Italian. Others fall back to English.
+// Example: FormatDateTimeLocale(t, "d 'de' MMMM 'de' yyyy", DateDialectICU, language.BrazilianPortuguese) returns "17 de outubro de 2026"
+func FormatDateTimeLocale(t time.Time, layout string, dialect DateDialect, lang language.Tag) (string, error) {
+	parts, err := parseDateLayout(layout, dialect)
+	if err != nil {
+		return "", err
+	}
+
+	return formatDateParts(t, parts, dateNamesFor(lang)), nil
+}
+
+// DateTimeAsStringLocale is DateTimeAsString with month and weekday names in the given language
+// Example: DateTimeAsStringLocale(t, "ww, d 'de' mmmm", language.BrazilianPortuguese) returns "sábado, 17 de outubro"
+func DateTimeAsStringLocale(dt time.Time, format string, lang language.Tag) string {
+	s, err := FormatDateTimeLocale(dt, format, DateDialectHandy, lang)
+	if err != nil {
+		return ""
+	}
+
+	return s
+}
+
+// DateReformatLocale is DateReformat with month and weekday names in the given language, both when parsing and formatting
+// Example: DateReformatLocale("17 de outubro de 2026", "d 'de' mmmm 'de' yyyy", "yyyy-mm-dd", language.Portuguese) returns "2026-10-17"
+func DateReformatLocale(d string, currentLayout, newLayout string, lang language.Tag) string {
+	return dateReformat(d, currentLayout, newLayout, DateDialectHandy, lang)
+}
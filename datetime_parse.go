@@ -0,0 +1,556 @@
+package stringo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	// ErrDateMismatch means a date string doesn't match its layout
+	ErrDateMismatch = errors.New("date doesn't match layout")
+	// ErrDateRange means a date component, like the month or the day, is out of range
+	ErrDateRange = errors.New("date component out of range")
+)
+
+// DateParseError describes why a date string couldn't be parsed. It wraps ErrDateMismatch or ErrDateRange.
+type DateParseError struct {
+	// Value is the date string
+	Value string
+	// Layout is the layout the date was parsed with
+	Layout string
+	// Offset is the byte offset, in Value, where parsing failed or the out of range component starts
+	Offset int
+	// Component is the out of range component, like "month" or "day". It's empty for mismatches.
+	Component string
+	// Err is ErrDateMismatch or ErrDateRange
+	Err error
+}
+
+// Error implements the error interface
+func (e *DateParseError) Error() string {
+	if e.Component != "" {
+		return fmt.Sprintf("date %q has %s out of range at %d", e.Value, e.Component, e.Offset)
+	}
+
+	return fmt.Sprintf("date %q doesn't match layout %q at %d", e.Value, e.Layout, e.Offset)
+}
+
+// Unwrap returns ErrDateMismatch or ErrDateRange, for errors.Is
+func (e *DateParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseDateOptions parametrizes ParseDate. The zero value parses strict layouts in DateDialectHandy notation, in UTC, with English names.
+type ParseDateOptions struct {
+	// Dialect is the notation of the layout
+	Dialect DateDialect
+	// Location is used when the date has no zone, and for Unix timestamps. Default is UTC
+	Location *time.Location
+	// Lenient accepts missing leading zeros, two digits years for four digits layouts, fractions of any length,
+	// full names for abbreviated ones and vice versa, any run of whitespace for a space, and surrounding whitespace
+	Lenient bool
+	// PivotYear decides the century of two digits years: below it they're 20xx, otherwise 19xx. Default is 69, as Go's time.Parse
+	PivotYear int
+	// Language of month and weekday names and meridiem markers. Default is English
+	Language language.Tag
+}
+
+// dateParser parses dates according the parts of a layout
+type dateParser struct {
+	names   *dateNames
+	loc     *time.Location
+	lenient bool
+	pivot   int
+}
+
+// dateValues are the components read from a date string
+type dateValues struct {
+	year, month, day, yearDay          int
+	hour, minute, second, nanosecond   int
+	hasMonthDay, hasYearDay, hasHour12 bool
+	pm                                 bool
+	zone                               *time.Location
+	unix                               *time.Time
+	// offsets are where each field was read, for errors
+	offsets [dateUnixMilli + 1]int
+}
+
+// dateDigits reads from min to max ASCII digits at the start of s, returning the value and how many were read
+func dateDigits(s string, min, max int) (int, int, bool) {
+	n := 0
+
+	for n < len(s) && n < max && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+
+	if n < min {
+		return 0, 0, false
+	}
+
+	v, _ := strconv.Atoi(s[:n])
+
+	return v, n, true
+}
+
+// dateName returns the index and length of the longest name at the start of s, case insensitive
+func dateName(s string, names ...string) (int, int, bool) {
+	best, length := -1, 0
+
+	for i, name := range names {
+		if len(name) > length && len(name) <= len(s) && strings.EqualFold(s[:len(name)], name) {
+			best, length = i, len(name)
+		}
+	}
+
+	return best, length, best >= 0
+}
+
+// dateZoneOffsetValue reads a zone offset like "-07", "-0700" or "-07:00", according the width, returning it in seconds
+func dateZoneOffsetValue(s string, width int) (int, int, bool) {
+	if s == "" || s[0] != '+' && s[0] != '-' {
+		return 0, 0, false
+	}
+
+	hours, n, ok := dateDigits(s[1:], 2, 2)
+	if !ok {
+		return 0, 0, false
+	}
+
+	read, minutes := 1+n, 0
+
+	switch width {
+	case 2:
+		if minutes, n, ok = dateDigits(s[read:], 2, 2); !ok {
+			return 0, 0, false
+		}
+
+		read += n
+	case 3:
+		if len(s) <= read || s[read] != ':' {
+			return 0, 0, false
+		}
+
+		if minutes, n, ok = dateDigits(s[read+1:], 2, 2); !ok {
+			return 0, 0, false
+		}
+
+		read += 1 + n
+	}
+
+	offset := hours*3600 + minutes*60
+
+	if s[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, read, true
+}
+
+// number reads a numeric component of the given width, which may have up to max digits
+// Lenient parsers accept a single digit instead of a padded number.
+func (dp dateParser) number(s string, width, max int) (int, int, bool) {
+	if dp.lenient {
+		return dateDigits(s, 1, max)
+	}
+
+	return dateDigits(s, width, max)
+}
+
+// year reads a year, applying the pivot to two digits years
+func (dp dateParser) year(s string, width int) (int, int, bool) {
+	year, n, ok := dateDigits(s, width, width)
+
+	// lenient parsers take four digits, if there are, otherwise two
+	if dp.lenient {
+		if year, n, ok = dateDigits(s, 4, 4); !ok {
+			year, n, ok = dateDigits(s, 2, 2)
+		}
+	}
+
+	if ok && n == 2 {
+		if year < dp.pivot {
+			year += 2000
+		} else {
+			year += 1900
+		}
+	}
+
+	return year, n, ok
+}
+
+// name reads a month or weekday name. Lenient parsers accept both full and abbreviated names, and a missing trailing period.
+func (dp dateParser) name(s string, full, short []string, abbreviated bool) (int, int, bool) {
+	names := full
+
+	if abbreviated {
+		names = short
+	}
+
+	if !dp.lenient {
+		return dateName(s, names...)
+	}
+
+	candidates := append(append([]string(nil), full...), short...)
+
+	for _, n := range short {
+		candidates = append(candidates, strings.TrimSuffix(n, "."))
+	}
+
+	i, n, ok := dateName(s, candidates...)
+
+	return i % len(full), n, ok
+}
+
+// literal reads literal text. Lenient parsers take any run of whitespace for a whitespace.
+func (dp dateParser) literal(s, literal string) (int, bool) {
+	if !dp.lenient {
+		return len(literal), strings.HasPrefix(s, literal)
+	}
+
+	read := 0
+
+	for _, r := range literal {
+		if unicode.IsSpace(r) {
+			n := len(s[read:]) - len(strings.TrimLeftFunc(s[read:], unicode.IsSpace))
+
+			if n == 0 {
+				return 0, false
+			}
+
+			read += n
+
+			continue
+		}
+
+		if !strings.HasPrefix(s[read:], string(r)) {
+			return 0, false
+		}
+
+		read += len(string(r))
+	}
+
+	return read, true
+}
+
+// read reads a part at the start of s into the values, returning how many bytes were read
+func (dp dateParser) read(s string, p datePart, v *dateValues) (int, bool) {
+	switch p.field {
+	case dateLiteral:
+		return dp.literal(s, p.literal)
+	case dateYear:
+		year, n, ok := dp.year(s, p.width)
+		v.year = year
+
+		return n, ok
+	case dateMonth:
+		if p.width >= 3 {
+			i, n, ok := dp.name(s, dp.names.months[:], dp.names.monthsShort[:], p.width == 3)
+			v.month, v.hasMonthDay = i+1, true
+
+			return n, ok
+		}
+
+		month, n, ok := dp.number(s, p.width, 2)
+		v.month, v.hasMonthDay = month, true
+
+		return n, ok
+	case dateDay, dateDaySpace:
+		skip, width := 0, p.width
+
+		if p.field == dateDaySpace {
+			width = 1
+
+			if strings.HasPrefix(s, " ") {
+				skip = 1
+			}
+		}
+
+		day, n, ok := dp.number(s[skip:], width, 2)
+		v.day, v.hasMonthDay = day, true
+
+		return skip + n, ok
+	case dateYearDay:
+		yearDay, n, ok := dp.number(s, p.width, 3)
+		v.yearDay, v.hasYearDay = yearDay, true
+
+		return n, ok
+	case dateWeekday:
+		// weekdays are checked for syntax only, like Go's time.Parse does
+		_, n, ok := dp.name(s, dp.names.weekdays[:], dp.names.weekdaysShort[:], p.width == 3)
+
+		return n, ok
+	case dateHour24:
+		hour, n, ok := dp.number(s, p.width, 2)
+		v.hour = hour
+
+		return n, ok
+	case dateHour12:
+		hour, n, ok := dp.number(s, p.width, 2)
+		v.hour, v.hasHour12 = hour, true
+
+		return n, ok
+	case dateMeridiem:
+		i, n, ok := dateName(s, dp.names.am, dp.names.pm)
+		v.pm = i == 1
+
+		return n, ok
+	case dateMinute:
+		minute, n, ok := dp.number(s, p.width, 2)
+		v.minute = minute
+
+		return n, ok
+	case dateSecond:
+		second, n, ok := dp.number(s, p.width, 2)
+		v.second = second
+
+		return n, ok
+	case dateFraction:
+		max := p.width
+
+		if dp.lenient {
+			max = 9
+		}
+
+		fraction, n, ok := dp.number(s, p.width, max)
+		v.nanosecond = fraction * pow10(9-n)
+
+		return n, ok
+	case dateZoneName:
+		n := 0
+
+		for n < len(s) && (s[n] >= 'A' && s[n] <= 'Z' || s[n] >= 'a' && s[n] <= 'z') {
+			n++
+		}
+
+		if n == 0 {
+			return 0, false
+		}
+
+		v.zone = dateZoneByName(s[:n], dp.loc)
+
+		return n, true
+	case dateZoneOffset, dateZoneISO:
+		if p.field == dateZoneISO && strings.HasPrefix(s, "Z") {
+			v.zone = time.UTC
+			return 1, true
+		}
+
+		offset, n, ok := dateZoneOffsetValue(s, p.width)
+		v.zone = time.FixedZone("", offset)
+
+		return n, ok
+	case dateUnix, dateUnixMilli:
+		skip := 0
+
+		if strings.HasPrefix(s, "-") {
+			skip = 1
+		}
+
+		_, n, ok := dateDigits(s[skip:], 1, 19)
+		if !ok {
+			return 0, false
+		}
+
+		value, err := strconv.ParseInt(s[:skip+n], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		t := time.Unix(value, 0)
+
+		if p.field == dateUnixMilli {
+			t = time.Unix(0, value*int64(time.Millisecond))
+		}
+
+		v.unix = &t
+
+		return skip + n, true
+	}
+
+	return 0, false
+}
+
+// pow10 returns 10 to the power of n, for n from 0 to 9
+func pow10(n int) int {
+	p := 1
+
+	for ; n > 0; n-- {
+		p *= 10
+	}
+
+	return p
+}
+
+// dateZoneByName returns the location for a zone abbreviation: UTC, the given location if it uses the abbreviation, or a zero offset zone with that name, as Go's time.Parse does
+func dateZoneByName(name string, loc *time.Location) *time.Location {
+	if name == "UTC" || name == "GMT" {
+		return time.UTC
+	}
+
+	for _, month := range []time.Month{time.January, time.July} {
+		if zone, _ := time.Date(time.Now().Year(), month, 1, 0, 0, 0, 0, loc).Zone(); zone == name {
+			return loc
+		}
+	}
+
+	return time.FixedZone(name, 0)
+}
+
+// dateFractionFollows tells if the layout parts start with fractional seconds, or a period or comma which may come before them
+func dateFractionFollows(parts []datePart) bool {
+	if len(parts) == 0 {
+		return false
+	}
+
+	p := parts[0]
+
+	return p.field == dateFraction || p.field == dateLiteral && (strings.HasPrefix(p.literal, ".") || strings.HasPrefix(p.literal, ","))
+}
+
+// dateSecondFraction reads fractional seconds of 1 to 9 digits, after a period or comma, returning how many bytes were read
+// Like Go's time.Parse, they're accepted right after the seconds even if the layout leaves them out.
+func dateSecondFraction(s string, v *dateValues) int {
+	if len(s) < 2 || s[0] != '.' && s[0] != ',' {
+		return 0
+	}
+
+	fraction, n, ok := dateDigits(s[1:], 1, 9)
+	if !ok {
+		return 0
+	}
+
+	v.nanosecond = fraction * pow10(9-n)
+
+	return 1 + n
+}
+
+// parse parses the date string according the layout parts
+func (dp dateParser) parse(s, layout string, parts []datePart) (time.Time, error) {
+	v := dateValues{month: 1, day: 1}
+	pos, end := 0, len(s)
+
+	if dp.lenient {
+		end = len(strings.TrimRightFunc(s, unicode.IsSpace))
+		pos = end - len(strings.TrimLeftFunc(s[:end], unicode.IsSpace))
+	}
+
+	for i, p := range parts {
+		n, ok := dp.read(s[pos:end], p, &v)
+		if !ok {
+			return time.Time{}, &DateParseError{Value: s, Layout: layout, Offset: pos, Err: ErrDateMismatch}
+		}
+
+		v.offsets[p.field] = pos
+
+		if p.field == dateDaySpace {
+			v.offsets[dateDay] = pos
+		}
+
+		pos += n
+
+		if p.field == dateSecond && !dateFractionFollows(parts[i+1:]) {
+			pos += dateSecondFraction(s[pos:end], &v)
+		}
+	}
+
+	if pos < end {
+		return time.Time{}, &DateParseError{Value: s, Layout: layout, Offset: pos, Err: ErrDateMismatch}
+	}
+
+	loc := dp.loc
+
+	if v.zone != nil {
+		loc = v.zone
+	}
+
+	if v.unix != nil {
+		return v.unix.In(loc), nil
+	}
+
+	t, field, component := v.build(loc)
+
+	if component != "" {
+		return time.Time{}, &DateParseError{Value: s, Layout: layout, Offset: v.offsets[field], Component: component, Err: ErrDateRange}
+	}
+
+	return t, nil
+}
+
+// build returns the time for the values, or the field and name of the first component out of range
+func (v dateValues) build(loc *time.Location) (time.Time, dateField, string) {
+	if v.hasHour12 {
+		if v.hour < 1 || v.hour > 12 {
+			return time.Time{}, dateHour12, "hour"
+		}
+
+		v.hour %= 12
+
+		if v.pm {
+			v.hour += 12
+		}
+	}
+
+	switch {
+	case v.month < 1 || v.month > 12:
+		return time.Time{}, dateMonth, "month"
+	case v.hour > 23:
+		return time.Time{}, dateHour24, "hour"
+	case v.minute > 59:
+		return time.Time{}, dateMinute, "minute"
+	case v.second > 59:
+		return time.Time{}, dateSecond, "second"
+	}
+
+	if v.hasYearDay {
+		t := time.Date(v.year, time.January, v.yearDay, v.hour, v.minute, v.second, v.nanosecond, loc)
+
+		if v.yearDay < 1 || t.Year() != v.year || v.hasMonthDay && (t.Month() != time.Month(v.month) || t.Day() != v.day) {
+			return time.Time{}, dateYearDay, "day of year"
+		}
+
+		return t, 0, ""
+	}
+
+	t := time.Date(v.year, time.Month(v.month), v.day, v.hour, v.minute, v.second, v.nanosecond, loc)
+
+	if v.day < 1 || t.Day() != v.day {
+		return time.Time{}, dateDay, "day"
+	}
+
+	return t, 0, ""
+}
+
+// newDateParser returns a parser for the options
+func newDateParser(opts ParseDateOptions) dateParser {
+	dp := dateParser{names: dateNamesFor(opts.Language), loc: opts.Location, lenient: opts.Lenient, pivot: opts.PivotYear}
+
+	if dp.loc == nil {
+		dp.loc = time.UTC
+	}
+
+	if dp.pivot == 0 {
+		dp.pivot = 69
+	}
+
+	return dp
+}
+
+// ParseDate parses a date string according a layout, in the notation of opts.Dialect
+// Layout errors wrap ErrDateLayout. Dates not matching the layout, or with components out of range, fail with a *DateParseError.
+// Like Go's time.Parse, fractional seconds of any length, after a period or comma, are accepted right after the seconds even if the layout leaves them out.
+// Example: ParseDate("7/3/26", "dd/mm/yyyy", ParseDateOptions{Lenient: true}) returns March 7th, 2026
+// Example: ParseDate("2026-02-30", "%Y-%m-%d", ParseDateOptions{Dialect: DateDialectStrftime}) fails with ErrDateRange, for the day
+func ParseDate(s, layout string, opts ParseDateOptions) (time.Time, error) {
+	parts, err := parseDateLayout(layout, opts.Dialect)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return newDateParser(opts).parse(s, layout, parts)
+}
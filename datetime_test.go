@@ -0,0 +1,434 @@
+package stringo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestFormatDateTime(t *testing.T) {
+	sp := time.FixedZone("-03", -3*60*60)
+	dt := time.Date(2026, 1, 7, 14, 5, 9, 123456789, sp)
+
+	tcs := []struct {
+		summary        string
+		layout         string
+		dialect        DateDialect
+		expectedOutput string
+	}{
+		{"handy", "dd/mm/yyyy hh24:nn:ss", DateDialectHandy, "07/01/2026 14:05:09"},
+		{"handy unpadded", "d/m/yy h:n:s", DateDialectHandy, "7/1/26 2 PM:5:9"},
+		{"handy names", "ww, mmmm d", DateDialectHandy, "Wednesday, January 7"},
+		{"handy abbreviated names", "w mmm", DateDialectHandy, "Wed Jan"},
+		{"handy quoted", "d 'de' mmmm 'de' yyyy", DateDialectHandy, "7 de January de 2026"},
+		{"handy quote", "d''yy", DateDialectHandy, "7'26"},
+		{"handy milliseconds and zone", "hh24:nn:ss.zzz tzd tz", DateDialectHandy, "14:05:09.123 -03:00 -03"},
+		{"handy unix", "unix", DateDialectHandy, "1767805509"},
+		{"handy unix milliseconds", "unixms", DateDialectHandy, "1767805509123"},
+		{"strftime", "%Y-%m-%d %H:%M:%S", DateDialectStrftime, "2026-01-07 14:05:09"},
+		{"strftime composites", "%F %T", DateDialectStrftime, "2026-01-07 14:05:09"},
+		{"strftime unpadded", "%-d/%-m %-I%P", DateDialectStrftime, "7/1 2pm"},
+		{"strftime space padded", "[%e]", DateDialectStrftime, "[ 7]"},
+		{"strftime names", "%a %b, %A %B", DateDialectStrftime, "Wed Jan, Wednesday January"},
+		{"strftime fractions", "%S.%L %S.%f %S.%N", DateDialectStrftime, "09.123 09.123456 09.123456789"},
+		{"strftime zones", "%z %:z %Z", DateDialectStrftime, "-0300 -03:00 -03"},
+		{"strftime day of year", "%j", DateDialectStrftime, "007"},
+		{"strftime percent", "100%%", DateDialectStrftime, "100%"},
+		{"icu", "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", DateDialectICU, "2026-01-07T14:05:09.123-03:00"},
+		{"icu names", "EEEE, d MMMM yy", DateDialectICU, "Wednesday, 7 January 26"},
+		{"icu quote", "h 'o''clock' a", DateDialectICU, "2 o'clock PM"},
+		{"icu zones", "X XX xxx Z z", DateDialectICU, "-03 -0300 -03:00 -0300 -03"},
+		{"moment", "YYYY-MM-DD HH:mm:ss.SSS Z", DateDialectMoment, "2026-01-07 14:05:09.123 -03:00"},
+		{"moment literals", "DD [de] MMMM, dddd", DateDialectMoment, "07 de January, Wednesday"},
+		{"moment unknown characters", "YYYY-MM-DDTHH:mm", DateDialectMoment, "2026-01-07T14:05"},
+		{"moment meridiem", "h:mm a", DateDialectMoment, "2:05 pm"},
+		{"moment unix", "X", DateDialectMoment, "1767805509"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r, err := FormatDateTime(dt, tc.layout, tc.dialect)
+
+			if err != nil || r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q, %v", tc.layout, tc.expectedOutput, r, err)
+			}
+		})
+	}
+}
+
+func TestFormatDateTimeISOZone(t *testing.T) {
+	dt := time.Date(2026, 1, 7, 14, 5, 9, 0, time.UTC)
+
+	if r := DateTimeAsString(dt, "yyyy-mm-dd'T'hh24:nn:sstzd"); r != "2026-01-07T14:05:09Z" {
+		t.Errorf("Test has failed!\n\tExpected: %q, \n\tGot: %q", "2026-01-07T14:05:09Z", r)
+	}
+}
+
+func TestFormatDateTimeErrors(t *testing.T) {
+	tcs := []struct {
+		summary string
+		layout  string
+		dialect DateDialect
+	}{
+		{"handy unterminated quote", "dd 'de mm", DateDialectHandy},
+		{"strftime unknown", "%Q", DateDialectStrftime},
+		{"strftime trailing percent", "%Y%", DateDialectStrftime},
+		{"icu unknown letter", "yyyy-MM-dd at HH", DateDialectICU},
+		{"icu unterminated quote", "yyyy 'at", DateDialectICU},
+		{"moment unsupported", "Do MMMM", DateDialectMoment},
+		{"moment unterminated bracket", "YYYY [at", DateDialectMoment},
+		{"unknown dialect", "yyyy", DateDialect(9)},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			_, err := FormatDateTime(time.Now(), tc.layout, tc.dialect)
+
+			if !errors.Is(err, ErrDateLayout) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v", tc.layout, ErrDateLayout, err)
+			}
+		})
+	}
+}
+
+func TestGoDateLayout(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		layout         string
+		dialect        DateDialect
+		expectedOutput string
+		expectedError  bool
+	}{
+		{"handy", "dd/mm/yyyy hh24:nn:ss.zzz", DateDialectHandy, "02/01/2006 15:04:05.000", false},
+		{"handy 12 hours", "hh:nn", DateDialectHandy, "03 PM:04", false},
+		{"handy month name isn't replaced again", "mmmm", DateDialectHandy, "January", false},
+		{"strftime", "%a, %d %b %Y %H:%M:%S %z", DateDialectStrftime, "Mon, 02 Jan 2006 15:04:05 -0700", false},
+		{"icu", "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", DateDialectICU, "2006-01-02T15:04:05.000Z07:00", false},
+		{"moment", "D/M/YY h:mm A", DateDialectMoment, "2/1/06 3:04 PM", false},
+		{"unix", "unix", DateDialectHandy, "", true},
+		{"fraction without period", "ssSSS", DateDialectICU, "", true},
+		{"literal with digits", "yyyy'1'", DateDialectICU, "", true},
+		{"literal with reserved word", "'Monday' yyyy", DateDialectICU, "", true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r, err := GoDateLayout(tc.layout, tc.dialect)
+
+			if r != tc.expectedOutput || (err != nil) != tc.expectedError {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q, %v", tc.layout, tc.expectedOutput, r, err)
+			}
+		})
+	}
+}
+
+func TestDateReformat(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		current        string
+		next           string
+		expectedOutput string
+	}{
+		{"iso to brazil", "2026-10-17", "yyyy-mm-dd", "dd/mm/yyyy", "17/10/2026"},
+		{"names", "17 October 2026", "d mmmm yyyy", "ww, mmm dd", "Saturday, Oct 17"},
+		{"12 hours", "2026-10-17 02 PM:05", "yyyy-mm-dd hh:nn", "hh24:nn", "14:05"},
+		{"milliseconds", "10:20:30.456", "hh24:nn:ss.zzz", "zzz", "456"},
+		{"from unix", "1767805509", "unix", "yyyy-mm-dd hh24:nn:ss", "2026-01-07 17:05:09"},
+		{"to unix", "2026-01-07 17:05:09", "yyyy-mm-dd hh24:nn:ss", "unix", "1767805509"},
+		{"mismatch", "17/10/2026", "yyyy-mm-dd", "dd/mm/yyyy", ""},
+		{"invalid layout", "2026", "'yyyy", "yyyy", ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := DateReformat(tc.input, tc.current, tc.next)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestDateTimeAsStringPreSeriesLayouts(t *testing.T) {
+	dt := time.Date(2026, 10, 17, 14, 5, 9, 0, time.UTC)
+
+	// outputs of the former strings.Replace based implementation, which must hold
+	tcs := []defaultTestStruct{
+		{"brazil", "dd/mm/yyyy", "17/10/2026"},
+		{"iso", "yyyy-mm-dd hh24:nn:ss", "2026-10-17 14:05:09"},
+		{"unpadded", "d/m/yy", "17/10/26"},
+		{"compact", "yyyymmdd", "20261017"},
+		{"12 hours", "w hh:nn", "Sat 02 PM:05"},
+		// breaking: the new tokens used to be printed as they were, and must be quoted now
+		{"new zone token", "hh24:nn:ss tz", "14:05:09 UTC"},
+		{"new zone token quoted", "hh24:nn:ss 'tz'", "14:05:09 tz"},
+		{"new milliseconds token", "yyyy-mm-dd zzz", "2026-10-17 000"},
+		{"new milliseconds token quoted", "yyyy-mm-dd 'zzz'", "2026-10-17 zzz"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := DateTimeAsString(dt, tc.input.(string))
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestDateTimeAsStringDialect(t *testing.T) {
+	dt := time.Date(2026, 10, 17, 14, 5, 9, 0, time.UTC)
+
+	tcs := []struct {
+		summary        string
+		format         string
+		dialect        DateDialect
+		expectedOutput string
+	}{
+		{"handy", "dd/mm/yyyy hh24:nn", DateDialectHandy, "17/10/2026 14:05"},
+		{"strftime", "%d/%m/%Y %H:%M", DateDialectStrftime, "17/10/2026 14:05"},
+		{"icu", "dd/MM/yyyy HH:mm 'h'", DateDialectICU, "17/10/2026 14:05 h"},
+		{"moment", "DD/MM/YYYY [at] HH:mm", DateDialectMoment, "17/10/2026 at 14:05"},
+		{"invalid", "Do MMMM", DateDialectMoment, ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := DateTimeAsStringDialect(dt, tc.format, tc.dialect)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.format, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestDateReformatDialect(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		current        string
+		next           string
+		dialect        DateDialect
+		expectedOutput string
+	}{
+		{"strftime", "2026-10-17", "%Y-%m-%d", "%d/%m/%Y", DateDialectStrftime, "17/10/2026"},
+		{"icu", "2026-10-17 14:05:09.123", "yyyy-MM-dd HH:mm:ss.SSS", "dd/MM/yy HH:mm", DateDialectICU, "17/10/26 14:05"},
+		{"moment", "17/10/2026", "DD/MM/YYYY", "YYYY-MM-DD", DateDialectMoment, "2026-10-17"},
+		{"handy", "2026-10-17", "yyyy-mm-dd", "dd/mm/yyyy", DateDialectHandy, "17/10/2026"},
+		{"mismatch", "17/10/2026", "%Y-%m-%d", "%d/%m/%Y", DateDialectStrftime, ""},
+		{"invalid layout", "17/10/2026", "DD/MM/YYYY", "Do", DateDialectMoment, ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := DateReformatDialect(tc.input, tc.current, tc.next, tc.dialect)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestDateTimeAsStringLocale(t *testing.T) {
+	dt := time.Date(2026, 10, 17, 14, 5, 0, 0, time.UTC)
+
+	tcs := []struct {
+		summary        string
+		format         string
+		lang           language.Tag
+		expectedOutput string
+	}{
+		{"english", "ww, mmmm d", language.English, "Saturday, October 17"},
+		{"portuguese", "ww, d 'de' mmmm 'de' yyyy", language.BrazilianPortuguese, "sábado, 17 de outubro de 2026"},
+		{"portuguese abbreviated", "w dd mmm", language.Portuguese, "sáb 17 out"},
+		{"spanish", "ww d 'de' mmmm, h", language.Spanish, "sábado 17 de octubre, 2 p. m."},
+		{"french", "ww d mmmm yyyy", language.French, "samedi 17 octobre 2026"},
+		{"french abbreviated", "w d mmm", language.French, "sam. 17 oct."},
+		{"german", "ww, d. mmmm yyyy", language.German, "Samstag, 17. Oktober 2026"},
+		{"italian", "ww d mmmm", language.Italian, "sabato 17 ottobre"},
+		{"fallback", "mmmm", language.Japanese, "October"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := DateTimeAsStringLocale(dt, tc.format, tc.lang)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.format, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestFormatDateTimeLocale(t *testing.T) {
+	dt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	r, err := FormatDateTimeLocale(dt, "EEEE, d 'de' MMMM", DateDialectICU, language.BrazilianPortuguese)
+
+	if err != nil || r != "segunda-feira, 2 de março" {
+		t.Errorf("Test has failed!\n\tExpected: %q, \n\tGot: %q, %v", "segunda-feira, 2 de março", r, err)
+	}
+}
+
+func TestDateReformatLocale(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		current        string
+		next           string
+		lang           language.Tag
+		expectedOutput string
+	}{
+		{"portuguese to iso", "17 de outubro de 2026", "d 'de' mmmm 'de' yyyy", "yyyy-mm-dd", language.BrazilianPortuguese, "2026-10-17"},
+		{"iso to portuguese", "2026-10-17", "yyyy-mm-dd", "d 'de' mmmm 'de' yyyy", language.BrazilianPortuguese, "17 de outubro de 2026"},
+		{"case insensitive", "17 DE OUTUBRO DE 2026", "d 'DE' mmmm 'DE' yyyy", "dd/mm/yyyy", language.Portuguese, "17/10/2026"},
+		{"with weekday", "sábado, 17 de outubro", "ww, d 'de' mmmm", "dd/mm", language.Portuguese, "17/10"},
+		{"spanish meridiem", "17/10/2026 2 p. m.", "dd/mm/yyyy h", "hh24:nn", language.Spanish, "14:00"},
+		{"german abbreviated", "17. Okt. 2026", "d. mmm yyyy", "yyyy-mm-dd", language.German, "2026-10-17"},
+		{"wrong language", "17 de outubro de 2026", "d 'de' mmmm 'de' yyyy", "yyyy-mm-dd", language.English, ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := DateReformatLocale(tc.input, tc.current, tc.next, tc.lang)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	sp := time.FixedZone("-03", -3*60*60)
+
+	tcs := []struct {
+		summary        string
+		input          string
+		layout         string
+		opts           ParseDateOptions
+		expectedOutput time.Time
+	}{
+		{"handy", "17/10/2026 14:05", "dd/mm/yyyy hh24:nn", ParseDateOptions{}, time.Date(2026, 10, 17, 14, 5, 0, 0, time.UTC)},
+		{"strftime", "2026-10-17T14:05:09.250", "%Y-%m-%dT%H:%M:%S.%L", ParseDateOptions{Dialect: DateDialectStrftime}, time.Date(2026, 10, 17, 14, 5, 9, 250000000, time.UTC)},
+		{"icu with zone", "2026-10-17T14:05:09.250-03:00", "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", ParseDateOptions{Dialect: DateDialectICU}, time.Date(2026, 10, 17, 14, 5, 9, 250000000, sp)},
+		{"icu utc", "2026-10-17T14:05:09Z", "yyyy-MM-dd'T'HH:mm:ssXXX", ParseDateOptions{Dialect: DateDialectICU}, time.Date(2026, 10, 17, 14, 5, 9, 0, time.UTC)},
+		{"moment", "17 Oct 2026, 2:05 pm", "D MMM YYYY, h:mm a", ParseDateOptions{Dialect: DateDialectMoment}, time.Date(2026, 10, 17, 14, 5, 0, 0, time.UTC)},
+		{"location", "17/10/2026 14:05", "dd/mm/yyyy hh24:nn", ParseDateOptions{Location: sp}, time.Date(2026, 10, 17, 14, 5, 0, 0, sp)},
+		{"lenient leading zeros", "7/3/2026", "dd/mm/yyyy", ParseDateOptions{Lenient: true}, time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"lenient two digits year", "7/3/26", "dd/mm/yyyy", ParseDateOptions{Lenient: true}, time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"lenient four digits year", "07/03/2026", "dd/mm/yy", ParseDateOptions{Lenient: true}, time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"pivot year", "07/03/30", "dd/mm/yy", ParseDateOptions{PivotYear: 30}, time.Date(1930, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"default pivot year", "07/03/30", "dd/mm/yy", ParseDateOptions{}, time.Date(2030, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"lenient whitespace", "  17   de outubro de 2026 ", "d 'de' mmmm 'de' yyyy", ParseDateOptions{Lenient: true, Language: language.Portuguese}, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"lenient names", "17 out 2026", "d mmmm yyyy", ParseDateOptions{Lenient: true, Language: language.Portuguese}, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"lenient missing period", "17 Okt 2026", "d mmm yyyy", ParseDateOptions{Lenient: true, Language: language.German}, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"lenient fraction", "09.5", "ss.zzz", ParseDateOptions{Lenient: true}, time.Date(0, 1, 1, 0, 0, 9, 500000000, time.UTC)},
+		{"fraction left out of layout", "10:20:30.123456", "hh24:nn:ss", ParseDateOptions{}, time.Date(0, 1, 1, 10, 20, 30, 123456000, time.UTC)},
+		{"fraction with comma left out of layout", "10:20:30,5", "hh24:nn:ss", ParseDateOptions{}, time.Date(0, 1, 1, 10, 20, 30, 500000000, time.UTC)},
+		{"day of year", "2026-290", "yyyy-DDD", ParseDateOptions{Dialect: DateDialectICU}, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"unix in location", "1767805509", "unix", ParseDateOptions{Location: sp}, time.Date(2026, 1, 7, 14, 5, 9, 0, sp)},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r, err := ParseDate(tc.input, tc.layout, tc.opts)
+			_, offset := r.Zone()
+			_, expectedOffset := tc.expectedOutput.Zone()
+
+			if err != nil || !r.Equal(tc.expectedOutput) || offset != expectedOffset {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v, %v", tc.input, tc.expectedOutput, r, err)
+			}
+		})
+	}
+}
+
+func TestParseDateErrors(t *testing.T) {
+	tcs := []struct {
+		summary           string
+		input             string
+		layout            string
+		opts              ParseDateOptions
+		expectedError     error
+		expectedOffset    int
+		expectedComponent string
+	}{
+		{"mismatch", "17/10/2026", "yyyy-mm-dd", ParseDateOptions{}, ErrDateMismatch, 0, ""},
+		{"missing leading zero", "2026-1-07", "yyyy-mm-dd", ParseDateOptions{}, ErrDateMismatch, 5, ""},
+		{"extra text", "2026-10-17 14:05", "yyyy-mm-dd", ParseDateOptions{}, ErrDateMismatch, 10, ""},
+		{"surrounding whitespace", " 2026-10-17", "yyyy-mm-dd", ParseDateOptions{}, ErrDateMismatch, 0, ""},
+		{"month", "2026-13-01", "yyyy-mm-dd", ParseDateOptions{}, ErrDateRange, 5, "month"},
+		{"day", "2026-02-30", "%Y-%m-%d", ParseDateOptions{Dialect: DateDialectStrftime}, ErrDateRange, 8, "day"},
+		{"hour", "25:00", "HH:mm", ParseDateOptions{Dialect: DateDialectICU}, ErrDateRange, 0, "hour"},
+		{"12 hours clock", "13:00 PM", "hh:mm A", ParseDateOptions{Dialect: DateDialectMoment}, ErrDateRange, 0, "hour"},
+		{"minute", "10:60", "hh24:nn", ParseDateOptions{}, ErrDateRange, 3, "minute"},
+		{"day of year", "2025-366", "%Y-%j", ParseDateOptions{Dialect: DateDialectStrftime}, ErrDateRange, 5, "day of year"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			_, err := ParseDate(tc.input, tc.layout, tc.opts)
+
+			var pe *DateParseError
+
+			if !errors.Is(err, tc.expectedError) || !errors.As(err, &pe) || pe.Offset != tc.expectedOffset || pe.Component != tc.expectedComponent {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v at %d %q, \n\tGot: %v", tc.input, tc.expectedError, tc.expectedOffset, tc.expectedComponent, err)
+			}
+		})
+	}
+
+	if _, err := ParseDate("2026", "%Q", ParseDateOptions{Dialect: DateDialectStrftime}); !errors.Is(err, ErrDateLayout) {
+		t.Errorf("Test has failed!\n\tExpected: %v, \n\tGot: %v", ErrDateLayout, err)
+	}
+}
+
+func TestDetectDateLayout(t *testing.T) {
+	tcs := []struct {
+		summary            string
+		samples            []string
+		expectedLayout     string
+		expectedConfidence float64
+	}{
+		{"empty", nil, "", 0},
+		{"blank", []string{" ", ""}, "", 0},
+		{"unknown", []string{"tomorrow", "soon"}, "", 0},
+		{"iso", []string{"2026-10-17", "2026-01-02"}, "yyyy-mm-dd", 1},
+		{"rfc 3339", []string{"2026-10-17T14:05:09Z", "2026-10-17T14:05:09-03:00"}, "yyyy-mm-dd'T'hh24:nn:sstzd", 1},
+		{"rfc 3339 milliseconds", []string{"2026-10-17T14:05:09.123Z"}, "yyyy-mm-dd'T'hh24:nn:ss.zzztzd", 1},
+		{"rfc 3339 microseconds", []string{"2026-10-17T10:00:00.123456Z"}, "yyyy-mm-dd'T'hh24:nn:sstzd", 1},
+		{"rfc 3339 mixed fractions", []string{"2026-10-17T10:00:00.1Z", "2026-10-17T10:00:00.12+01:00", "2026-10-17T10:00:00.123Z"},
+			"yyyy-mm-dd'T'hh24:nn:sstzd", 1},
+		{"iso fraction without zone", []string{"2026-10-17T10:00:00.123456789"}, "yyyy-mm-dd'T'hh24:nn:ss", 1},
+		{"day first", []string{"03/04/2026", "25/12/2026"}, "dd/mm/yyyy", 1},
+		{"month first", []string{"03/04/2026", "12/25/2026"}, "mm/dd/yyyy", 1},
+		{"ambiguous", []string{"03/04/2026", "05/06/2026"}, "dd/mm/yyyy", 0.5},
+		{"unpadded", []string{"3/4/2026", "25/12/2026"}, "d/m/yyyy", 1},
+		{"two digits year", []string{"3/4/26", "12/25/26"}, "m/d/yy", 1},
+		{"compact", []string{"20261017", "20260102"}, "yyyymmdd", 1},
+		{"unix seconds", []string{"1767805509", "1760000000"}, "unix", 1},
+		{"unix milliseconds", []string{"1767805509123"}, "unixms", 1},
+		{"unix seconds in the 1970s", []string{"123456789"}, "unix", 1},
+		{"small numbers aren't timestamps", []string{"42", "7"}, "", 0},
+		{"years aren't timestamps", []string{"2026", "1999"}, "", 0},
+		{"too short for milliseconds", []string{"17678055091"}, "", 0},
+		{"month names", []string{"Oct 17, 2026", "Jan 2, 2026"}, "mmm d, yyyy", 1},
+		{"some garbage", []string{"2026-10-17", "2026-01-02", "n/a", "2026-02-30"}, "yyyy-mm-dd", 0.5},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			layout, confidence := DetectDateLayout(tc.samples)
+
+			if layout != tc.expectedLayout || confidence != tc.expectedConfidence {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, %v \n\tGot: %q, %v", tc.samples, tc.expectedLayout, tc.expectedConfidence, layout, confidence)
+			}
+		})
+	}
+}
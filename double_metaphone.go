@@ -0,0 +1,622 @@
+package stringo
+
+import "strings"
+
+// doubleMetaphoneMaxLen is the length of Double Metaphone keys
+const doubleMetaphoneMaxLen = 4
+
+// doubleMetaphone holds the state of a Double Metaphone encoding: the name and both keys being built
+type doubleMetaphone struct {
+	value          []rune
+	primary        strings.Builder
+	alternate      strings.Builder
+	slavoGermanic  bool
+	primaryCount   int
+	alternateCount int
+}
+
+// appendPrimary adds to the primary key, up to doubleMetaphoneMaxLen
+func (dm *doubleMetaphone) appendPrimary(s string) {
+	for _, r := range s {
+		if dm.primaryCount < doubleMetaphoneMaxLen {
+			dm.primary.WriteRune(r)
+			dm.primaryCount++
+		}
+	}
+}
+
+// appendAlternate adds to the alternate key, up to doubleMetaphoneMaxLen
+func (dm *doubleMetaphone) appendAlternate(s string) {
+	for _, r := range s {
+		if dm.alternateCount < doubleMetaphoneMaxLen {
+			dm.alternate.WriteRune(r)
+			dm.alternateCount++
+		}
+	}
+}
+
+// appendBoth adds to both keys the same sound
+func (dm *doubleMetaphone) appendBoth(s string) {
+	dm.appendPrimary(s)
+	dm.appendAlternate(s)
+}
+
+// appendEach adds different sounds to the primary and alternate keys
+func (dm *doubleMetaphone) appendEach(primary, alternate string) {
+	dm.appendPrimary(primary)
+	dm.appendAlternate(alternate)
+}
+
+// complete tells if both keys are full
+func (dm *doubleMetaphone) complete() bool {
+	return dm.primaryCount >= doubleMetaphoneMaxLen && dm.alternateCount >= doubleMetaphoneMaxLen
+}
+
+// at returns the letter at the given position, or 0 if it's out of the name
+func (dm *doubleMetaphone) at(i int) rune {
+	if i < 0 || i >= len(dm.value) {
+		return 0
+	}
+
+	return dm.value[i]
+}
+
+// contains tells if the substring at the given position, with the given length, is any of the criteria
+func (dm *doubleMetaphone) contains(start, length int, criteria ...string) bool {
+	if start < 0 || start+length > len(dm.value) {
+		return false
+	}
+
+	sub := string(dm.value[start : start+length])
+
+	for _, c := range criteria {
+		if sub == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vowel tells if the letter at the given position is a vowel, Y included
+func (dm *doubleMetaphone) vowel(i int) bool {
+	return strings.ContainsRune("AEIOUY", dm.at(i))
+}
+
+// last is the position of the last letter
+func (dm *doubleMetaphone) last() int {
+	return len(dm.value) - 1
+}
+
+// skip returns the position after the current letter, skipping the next one if it's any of the given letters
+func (dm *doubleMetaphone) skip(i int, letters string) int {
+	if dm.at(i+1) != 0 && strings.ContainsRune(letters, dm.at(i+1)) {
+		return i + 2
+	}
+
+	return i + 1
+}
+
+// germanic tells if the name starts like a Germanic or Dutch one
+func (dm *doubleMetaphone) germanic() bool {
+	return dm.contains(0, 4, "VAN ", "VON ") || dm.contains(0, 3, "SCH")
+}
+
+func (dm *doubleMetaphone) handleC(i int) int {
+	switch {
+	case dm.conditionC0(i):
+		// Germanic "-ACH-", like "Bacher"
+		dm.appendBoth("K")
+		return i + 2
+	case i == 0 && dm.contains(i, 6, "CAESAR"):
+		dm.appendBoth("S")
+		return i + 2
+	case dm.contains(i, 2, "CH"):
+		return dm.handleCH(i)
+	case dm.contains(i, 2, "CZ") && !dm.contains(i-2, 4, "WICZ"):
+		dm.appendEach("S", "X")
+		return i + 2
+	case dm.contains(i+1, 3, "CIA"):
+		dm.appendBoth("X")
+		return i + 3
+	case dm.contains(i, 2, "CC") && !(i == 1 && dm.at(0) == 'M'):
+		return dm.handleCC(i)
+	case dm.contains(i, 2, "CK", "CG", "CQ"):
+		dm.appendBoth("K")
+		return i + 2
+	case dm.contains(i, 2, "CI", "CE", "CY"):
+		if dm.contains(i, 3, "CIO", "CIE", "CIA") {
+			dm.appendEach("S", "X")
+		} else {
+			dm.appendBoth("S")
+		}
+
+		return i + 2
+	}
+
+	dm.appendBoth("K")
+
+	switch {
+	case dm.contains(i+1, 2, " C", " Q", " G"):
+		return i + 3
+	case dm.contains(i+1, 1, "C", "K", "Q") && !dm.contains(i+1, 2, "CE", "CI"):
+		return i + 2
+	}
+
+	return i + 1
+}
+
+func (dm *doubleMetaphone) conditionC0(i int) bool {
+	switch {
+	case dm.contains(i, 4, "CHIA"):
+		return true
+	case i <= 1, dm.vowel(i - 2), !dm.contains(i-1, 3, "ACH"):
+		return false
+	}
+
+	c := dm.at(i + 2)
+
+	return (c != 'I' && c != 'E') || dm.contains(i-2, 6, "BACHER", "MACHER")
+}
+
+func (dm *doubleMetaphone) handleCC(i int) int {
+	if dm.contains(i+2, 1, "I", "E", "H") && !dm.contains(i+2, 2, "HU") {
+		// "Accident" and "accede", but "bellocchio" and "bacchus"
+		if (i == 1 && dm.at(0) == 'A') || dm.contains(i-1, 5, "UCCEE", "UCCES") {
+			dm.appendBoth("KS")
+		} else {
+			dm.appendBoth("X")
+		}
+
+		return i + 3
+	}
+
+	dm.appendBoth("K")
+
+	return i + 2
+}
+
+func (dm *doubleMetaphone) handleCH(i int) int {
+	switch {
+	case i > 0 && dm.contains(i, 4, "CHAE"):
+		// "Michael"
+		dm.appendEach("K", "X")
+	case dm.conditionCH0(i), dm.conditionCH1(i):
+		dm.appendBoth("K")
+	case i > 0 && dm.contains(0, 2, "MC"):
+		dm.appendBoth("K")
+	case i > 0:
+		dm.appendEach("X", "K")
+	default:
+		dm.appendBoth("X")
+	}
+
+	return i + 2
+}
+
+// conditionCH0 matches Greek roots at the start, like "chemistry" and "chorus"
+func (dm *doubleMetaphone) conditionCH0(i int) bool {
+	if i != 0 || dm.contains(0, 5, "CHORE") {
+		return false
+	}
+
+	return dm.contains(i+1, 5, "HARAC", "HARIS") || dm.contains(i+1, 3, "HOR", "HYM", "HIA", "HEM")
+}
+
+// conditionCH1 matches Germanic names and Greek roots in the middle, like "orchestra" and "architect"
+func (dm *doubleMetaphone) conditionCH1(i int) bool {
+	return dm.germanic() ||
+		dm.contains(i-2, 6, "ORCHES", "ARCHIT", "ORCHID") ||
+		dm.contains(i+2, 1, "T", "S") ||
+		((dm.contains(i-1, 1, "A", "O", "U", "E") || i == 0) &&
+			(dm.contains(i+2, 1, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") || i+1 == dm.last()))
+}
+
+func (dm *doubleMetaphone) handleD(i int) int {
+	switch {
+	case dm.contains(i, 2, "DG") && dm.contains(i+2, 1, "I", "E", "Y"):
+		// "Edge"
+		dm.appendBoth("J")
+		return i + 3
+	case dm.contains(i, 2, "DG"):
+		// "Edgar"
+		dm.appendBoth("TK")
+		return i + 2
+	case dm.contains(i, 2, "DT", "DD"):
+		dm.appendBoth("T")
+		return i + 2
+	}
+
+	dm.appendBoth("T")
+
+	return i + 1
+}
+
+func (dm *doubleMetaphone) handleG(i int) int {
+	switch {
+	case dm.at(i+1) == 'H':
+		return dm.handleGH(i)
+	case dm.at(i+1) == 'N':
+		switch {
+		case i == 1 && dm.vowel(0) && !dm.slavoGermanic:
+			dm.appendEach("KN", "N")
+		case !dm.contains(i+2, 2, "EY") && dm.at(i+1) != 'Y' && !dm.slavoGermanic:
+			dm.appendEach("N", "KN")
+		default:
+			dm.appendBoth("KN")
+		}
+
+		return i + 2
+	case dm.contains(i+1, 2, "LI") && !dm.slavoGermanic:
+		// "Tagliaro"
+		dm.appendEach("KL", "L")
+		return i + 2
+	case i == 0 && (dm.at(i+1) == 'Y' || dm.contains(i+1, 2, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		dm.appendEach("K", "J")
+		return i + 2
+	case (dm.contains(i+1, 2, "ER") || dm.at(i+1) == 'Y') && !dm.contains(0, 6, "DANGER", "RANGER", "MANGER") &&
+		!dm.contains(i-1, 1, "E", "I") && !dm.contains(i-1, 3, "RGY", "OGY"):
+		dm.appendEach("K", "J")
+		return i + 2
+	case dm.contains(i+1, 1, "E", "I", "Y") || dm.contains(i-1, 4, "AGGI", "OGGI"):
+		switch {
+		case dm.germanic() || dm.contains(i+1, 2, "ET"):
+			dm.appendBoth("K")
+		case dm.contains(i+1, 3, "IER"):
+			dm.appendBoth("J")
+		default:
+			dm.appendEach("J", "K")
+		}
+
+		return i + 2
+	}
+
+	dm.appendBoth("K")
+
+	return dm.skip(i, "G")
+}
+
+func (dm *doubleMetaphone) handleGH(i int) int {
+	switch {
+	case i > 0 && !dm.vowel(i-1):
+		dm.appendBoth("K")
+	case i == 0:
+		// "Ghislane" and "Ghiradelli"
+		if dm.at(i+2) == 'I' {
+			dm.appendBoth("J")
+		} else {
+			dm.appendBoth("K")
+		}
+	case (i > 1 && dm.contains(i-2, 1, "B", "H", "D")) || (i > 2 && dm.contains(i-3, 1, "B", "H", "D")) ||
+		(i > 3 && dm.contains(i-4, 1, "B", "H")):
+		// Silent, like "Hugh", "bough" and "broughton"
+	case i > 2 && dm.at(i-1) == 'U' && dm.contains(i-3, 1, "C", "G", "L", "R", "T"):
+		// "Laugh", "McLaughlin", "cough", "rough" and "tough"
+		dm.appendBoth("F")
+	case i > 0 && dm.at(i-1) != 'I':
+		dm.appendBoth("K")
+	}
+
+	return i + 2
+}
+
+func (dm *doubleMetaphone) handleH(i int) int {
+	// Only kept between vowels, or at the start before a vowel
+	if (i == 0 || dm.vowel(i-1)) && dm.vowel(i+1) {
+		dm.appendBoth("H")
+		return i + 2
+	}
+
+	return i + 1
+}
+
+func (dm *doubleMetaphone) handleJ(i int) int {
+	if dm.contains(i, 4, "JOSE") || dm.contains(0, 4, "SAN ") {
+		// Spanish "Jose" and "San Jacinto"
+		if (i == 0 && dm.at(i+4) == ' ') || len(dm.value) == 4 || dm.contains(0, 4, "SAN ") {
+			dm.appendBoth("H")
+		} else {
+			dm.appendEach("J", "H")
+		}
+
+		return i + 1
+	}
+
+	switch {
+	case i == 0:
+		dm.appendEach("J", "A")
+	case dm.vowel(i-1) && !dm.slavoGermanic && (dm.at(i+1) == 'A' || dm.at(i+1) == 'O'):
+		dm.appendEach("J", "H")
+	case i == dm.last():
+		dm.appendPrimary("J")
+	case !dm.contains(i+1, 1, "L", "T", "K", "S", "N", "M", "B", "Z") && !dm.contains(i-1, 1, "S", "K", "L"):
+		dm.appendBoth("J")
+	}
+
+	return dm.skip(i, "J")
+}
+
+func (dm *doubleMetaphone) handleL(i int) int {
+	if dm.at(i+1) != 'L' {
+		dm.appendBoth("L")
+		return i + 1
+	}
+
+	// Spanish "-illo", "-illa" and "-alle", like "Cabrillo" and "Gallegos"
+	if (i == len(dm.value)-3 && dm.contains(i-1, 4, "ILLO", "ILLA", "ALLE")) ||
+		((dm.contains(dm.last()-1, 2, "AS", "OS") || dm.contains(dm.last(), 1, "A", "O")) && dm.contains(i-1, 4, "ALLE")) {
+		dm.appendPrimary("L")
+	} else {
+		dm.appendBoth("L")
+	}
+
+	return i + 2
+}
+
+func (dm *doubleMetaphone) handleM(i int) int {
+	dm.appendBoth("M")
+
+	// "Dumb" and "thumb"
+	if dm.at(i+1) == 'M' || (dm.contains(i-1, 3, "UMB") && (i+1 == dm.last() || dm.contains(i+2, 2, "ER"))) {
+		return i + 2
+	}
+
+	return i + 1
+}
+
+func (dm *doubleMetaphone) handleP(i int) int {
+	if dm.at(i+1) == 'H' {
+		dm.appendBoth("F")
+		return i + 2
+	}
+
+	// "Campbell" and "raspberry"
+	dm.appendBoth("P")
+
+	return dm.skip(i, "PB")
+}
+
+func (dm *doubleMetaphone) handleR(i int) int {
+	// French "Rogier", but not "Hochmeier"
+	if i == dm.last() && !dm.slavoGermanic && dm.contains(i-2, 2, "IE") && !dm.contains(i-4, 2, "ME", "MA") {
+		dm.appendAlternate("R")
+	} else {
+		dm.appendBoth("R")
+	}
+
+	return dm.skip(i, "R")
+}
+
+func (dm *doubleMetaphone) handleS(i int) int {
+	switch {
+	case dm.contains(i-1, 3, "ISL", "YSL"):
+		// Silent, like "island" and "Carlisle"
+		return i + 1
+	case i == 0 && dm.contains(i, 5, "SUGAR"):
+		dm.appendEach("X", "S")
+		return i + 1
+	case dm.contains(i, 2, "SH"):
+		// Germanic "Holmes"
+		if dm.contains(i+1, 4, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			dm.appendBoth("S")
+		} else {
+			dm.appendBoth("X")
+		}
+
+		return i + 2
+	case dm.contains(i, 3, "SIO", "SIA") || dm.contains(i, 4, "SIAN"):
+		// Italian and Armenian
+		if dm.slavoGermanic {
+			dm.appendBoth("S")
+		} else {
+			dm.appendEach("S", "X")
+		}
+
+		return i + 3
+	case (i == 0 && dm.contains(i+1, 1, "M", "N", "L", "W")) || dm.contains(i+1, 1, "Z"):
+		// German and anglicisations, like "Smith" and "Schmidt", "Snider" and "Schneider"
+		dm.appendEach("S", "X")
+
+		return dm.skip(i, "Z")
+	case dm.contains(i, 2, "SC"):
+		return dm.handleSC(i)
+	}
+
+	// French "Resnais" and "Artois"
+	if i == dm.last() && dm.contains(i-2, 2, "AI", "OI") {
+		dm.appendAlternate("S")
+	} else {
+		dm.appendBoth("S")
+	}
+
+	return dm.skip(i, "SZ")
+}
+
+func (dm *doubleMetaphone) handleSC(i int) int {
+	switch {
+	case dm.at(i+2) == 'H':
+		switch {
+		case dm.contains(i+3, 2, "ER", "EN"):
+			// Dutch "Schermerhorn" and "Schenker"
+			dm.appendEach("X", "SK")
+		case dm.contains(i+3, 2, "OO", "UY", "ED", "EM"):
+			dm.appendBoth("SK")
+		case i == 0 && !dm.vowel(3) && dm.at(3) != 'W':
+			dm.appendEach("X", "S")
+		default:
+			dm.appendBoth("X")
+		}
+	case dm.contains(i+2, 1, "I", "E", "Y"):
+		dm.appendBoth("S")
+	default:
+		dm.appendBoth("SK")
+	}
+
+	return i + 3
+}
+
+func (dm *doubleMetaphone) handleT(i int) int {
+	switch {
+	case dm.contains(i, 4, "TION"), dm.contains(i, 3, "TIA", "TCH"):
+		dm.appendBoth("X")
+		return i + 3
+	case dm.contains(i, 2, "TH") || dm.contains(i, 3, "TTH"):
+		// "Thomas" and "Thames"
+		if dm.contains(i+2, 2, "OM", "AM") || dm.germanic() {
+			dm.appendBoth("T")
+		} else {
+			dm.appendEach("0", "T")
+		}
+
+		return i + 2
+	}
+
+	dm.appendBoth("T")
+
+	return dm.skip(i, "TD")
+}
+
+func (dm *doubleMetaphone) handleW(i int) int {
+	switch {
+	case dm.contains(i, 2, "WR"):
+		dm.appendBoth("R")
+		return i + 2
+	case i == 0 && (dm.vowel(i+1) || dm.contains(i, 2, "WH")):
+		// "Wasserman" should match "Vasserman"
+		if dm.vowel(i + 1) {
+			dm.appendEach("A", "F")
+		} else {
+			dm.appendBoth("A")
+		}
+	case (i == dm.last() && dm.vowel(i-1)) || dm.contains(i-1, 5, "EWSKI", "EWSKY", "OWSKI", "OWSKY") || dm.contains(0, 3, "SCH"):
+		// Polish "Filipowicz" and Germanic "Arnow"
+		dm.appendAlternate("F")
+	case dm.contains(i, 4, "WICZ", "WITZ"):
+		dm.appendEach("TS", "FX")
+		return i + 4
+	}
+
+	return i + 1
+}
+
+func (dm *doubleMetaphone) handleX(i int) int {
+	if i == 0 {
+		// "Xavier"
+		dm.appendBoth("S")
+		return i + 1
+	}
+
+	// French "Breaux"
+	if !(i == dm.last() && (dm.contains(i-3, 3, "IAU", "EAU") || dm.contains(i-2, 2, "AU", "OU"))) {
+		dm.appendBoth("KS")
+	}
+
+	return dm.skip(i, "CX")
+}
+
+func (dm *doubleMetaphone) handleZ(i int) int {
+	if dm.at(i+1) == 'H' {
+		// Chinese pinyin, like "Zhao"
+		dm.appendBoth("J")
+		return i + 2
+	}
+
+	if dm.contains(i+1, 2, "ZO", "ZI", "ZA") || (dm.slavoGermanic && i > 0 && dm.at(i-1) != 'T') {
+		dm.appendEach("S", "TS")
+	} else {
+		dm.appendBoth("S")
+	}
+
+	return dm.skip(i, "Z")
+}
+
+// encode runs the Double Metaphone rules over the whole name
+func (dm *doubleMetaphone) encode() {
+	s := string(dm.value)
+	dm.slavoGermanic = strings.Contains(s, "W") || strings.Contains(s, "K") || strings.Contains(s, "CZ") || strings.Contains(s, "WITZ")
+
+	i := 0
+
+	// Silent first letters, like "Gnome", "Knight", "Pneumonia", "Wright" and "Psychology"
+	if dm.contains(0, 2, "GN", "KN", "PN", "WR", "PS") {
+		i = 1
+	}
+
+	for !dm.complete() && i < len(dm.value) {
+		switch c := dm.value[i]; c {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			// Vowels are only coded at the start
+			if i == 0 {
+				dm.appendBoth("A")
+			}
+
+			i++
+		case 'B':
+			dm.appendBoth("P")
+			i = dm.skip(i, "B")
+		case 'Ç':
+			dm.appendBoth("S")
+			i++
+		case 'C':
+			i = dm.handleC(i)
+		case 'D':
+			i = dm.handleD(i)
+		case 'F':
+			dm.appendBoth("F")
+			i = dm.skip(i, "F")
+		case 'G':
+			i = dm.handleG(i)
+		case 'H':
+			i = dm.handleH(i)
+		case 'J':
+			i = dm.handleJ(i)
+		case 'K':
+			dm.appendBoth("K")
+			i = dm.skip(i, "K")
+		case 'L':
+			i = dm.handleL(i)
+		case 'M':
+			i = dm.handleM(i)
+		case 'N':
+			dm.appendBoth("N")
+			i = dm.skip(i, "N")
+		case 'Ñ':
+			dm.appendBoth("N")
+			i++
+		case 'P':
+			i = dm.handleP(i)
+		case 'Q':
+			dm.appendBoth("K")
+			i = dm.skip(i, "Q")
+		case 'R':
+			i = dm.handleR(i)
+		case 'S':
+			i = dm.handleS(i)
+		case 'T':
+			i = dm.handleT(i)
+		case 'V':
+			dm.appendBoth("F")
+			i = dm.skip(i, "V")
+		case 'W':
+			i = dm.handleW(i)
+		case 'X':
+			i = dm.handleX(i)
+		case 'Z':
+			i = dm.handleZ(i)
+		default:
+			i++
+		}
+	}
+}
+
+// DoubleMetaphone returns the primary and alternate Double Metaphone keys of the given name, up to 4 characters each
+// The alternate key covers other pronunciations, usually from the name origin, and it's the same as the primary key if there's none.
+// "0" stands for "th", and "X" for "sh" and "ch". Accented and non Latin letters are transliterated first, except "Ç" and "Ñ".
+// Example: DoubleMetaphone("Smith") returns "SM0", "XMT", and DoubleMetaphone("Schmidt") returns "XMT", "SMT"
+func DoubleMetaphone(s string) (string, string) {
+	dm := &doubleMetaphone{value: []rune(phoneticUpper(s, func(r rune) bool { return r == 'Ç' || r == 'Ñ' }))}
+
+	dm.encode()
+
+	return dm.primary.String(), dm.alternate.String()
+}
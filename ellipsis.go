@@ -0,0 +1,234 @@
+package stringo
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type EllipsizeMode uint8
+
+const (
+	// EllipsizeEnd keeps the beginning of the string. I.E: "The quick brown…"
+	EllipsizeEnd EllipsizeMode = 0
+	// EllipsizeStart keeps the end of the string. I.E: "…brown fox jumps"
+	EllipsizeStart EllipsizeMode = 1
+	// EllipsizeMiddle keeps both the beginning and the end of the string. I.E: "/very/long/…/file.go"
+	EllipsizeMiddle EllipsizeMode = 2
+)
+
+type EllipsizeBudget uint8
+
+const (
+	// EllipsizeRunes measures the maximum length in runes
+	EllipsizeRunes EllipsizeBudget = 0
+	// EllipsizeWidth measures the maximum length in display columns, where East Asian wide characters and emoji count double
+	EllipsizeWidth EllipsizeBudget = 1
+)
+
+const ellipsisDefault = "…"
+
+// EllipsizeOptions parametrizes Ellipsize. The zero value cuts the end, with "…", counting runes.
+type EllipsizeOptions struct {
+	// Mode tells which part of the string is elided
+	Mode EllipsizeMode
+	// Ellipsis replaces the elided part, and counts in the maximum length. Default is "…"
+	Ellipsis string
+	// Budget tells how the maximum length is measured
+	Budget EllipsizeBudget
+	// WordBoundary avoids cutting words in half, as long as there's a space or punctuation to cut at
+	WordBoundary bool
+	// KeepExtension preserves the file extension of paths and file names. I.E: "very-long-file-na….txt"
+	KeepExtension bool
+}
+
+// ellipsizeCluster is a grapheme cluster with its cost, according the budget
+type ellipsizeCluster struct {
+	text     string
+	cost     int
+	boundary bool
+	space    bool
+}
+
+// ellipsizeClusters splits the given string into grapheme clusters, measuring each one
+func ellipsizeClusters(s string, budget EllipsizeBudget) []ellipsizeCluster {
+	var clusters []ellipsizeCluster
+
+	for _, g := range Graphemes(s) {
+		r, _ := utf8.DecodeRuneInString(g)
+
+		c := ellipsizeCluster{
+			text:     g,
+			cost:     utf8.RuneCountInString(g),
+			boundary: unicode.IsSpace(r) || unicode.IsPunct(r),
+			space:    unicode.IsSpace(r),
+		}
+
+		if budget == EllipsizeWidth {
+			c.cost = graphemeWidth(g)
+		}
+
+		clusters = append(clusters, c)
+	}
+
+	return clusters
+}
+
+// ellipsizeCost sums the cost of the given clusters
+func ellipsizeCost(clusters []ellipsizeCluster) int {
+	cost := 0
+
+	for _, c := range clusters {
+		cost += c.cost
+	}
+
+	return cost
+}
+
+// ellipsizeJoin concatenates the given clusters
+func ellipsizeJoin(clusters []ellipsizeCluster) string {
+	var sb strings.Builder
+
+	for _, c := range clusters {
+		sb.WriteString(c.text)
+	}
+
+	return sb.String()
+}
+
+// ellipsizeHead returns the leading clusters that fit the budget, optionally backing off to a word boundary
+func ellipsizeHead(clusters []ellipsizeCluster, budget int, wordBoundary bool) []ellipsizeCluster {
+	k, cost := 0, 0
+
+	for k < len(clusters) && cost+clusters[k].cost <= budget {
+		cost += clusters[k].cost
+		k++
+	}
+
+	if wordBoundary && k > 0 && k < len(clusters) && !clusters[k].boundary && !clusters[k-1].boundary {
+		for j := k - 1; j > 0; j-- {
+			if clusters[j].boundary {
+				// Keeps separators like "/", but not spaces
+				if k = j; !clusters[j].space {
+					k++
+				}
+
+				break
+			}
+		}
+	}
+
+	for k > 0 && clusters[k-1].space {
+		k--
+	}
+
+	return clusters[:k]
+}
+
+// ellipsizeTail returns the trailing clusters that fit the budget, optionally backing off to a word boundary
+func ellipsizeTail(clusters []ellipsizeCluster, budget int, wordBoundary bool) []ellipsizeCluster {
+	k, cost := len(clusters), 0
+
+	for k > 0 && cost+clusters[k-1].cost <= budget {
+		cost += clusters[k-1].cost
+		k--
+	}
+
+	if wordBoundary && k < len(clusters) && k > 0 && !clusters[k].boundary && !clusters[k-1].boundary {
+		for j := k + 1; j < len(clusters); j++ {
+			if clusters[j].boundary {
+				if k = j; clusters[j].space {
+					k++
+				}
+
+				break
+			}
+		}
+	}
+
+	for k < len(clusters) && clusters[k].space {
+		k++
+	}
+
+	return clusters[k:]
+}
+
+// fileExtension returns the extension of the last path component, like ".go", or "" if there's none
+func fileExtension(s string) string {
+	start := strings.LastIndexAny(s, `/\`) + 1
+	dot := strings.LastIndex(s, ".")
+
+	// Hidden files, like ".bashrc", have no extension
+	if dot <= start || dot == len(s)-1 {
+		return ""
+	}
+
+	ext := s[dot:]
+
+	if utf8.RuneCountInString(ext) > 10 || strings.ContainsAny(ext, " \t") {
+		return ""
+	}
+
+	return ext
+}
+
+// Ellipsize shortens the given string to maxLen, replacing the elided part with an ellipsis, which counts in the budget
+// Unlike Truncate, it never breaks a glyph, and it can keep the end or both ends of the string, as well as file extensions.
+// If maxLen can't even hold the ellipsis, the string is just truncated. maxLen<1 means no limit
+// Example: Ellipsize("The quick brown fox", 12, EllipsizeOptions{WordBoundary: true}) returns "The quick…"
+// Example: Ellipsize("/very/long/path/to/some/file.go", 20, EllipsizeOptions{Mode: EllipsizeMiddle, WordBoundary: true}) returns "/very/long/…/file.go"
+func Ellipsize(s string, maxLen int, opts EllipsizeOptions) string {
+	if maxLen < 1 {
+		return s
+	}
+
+	clusters := ellipsizeClusters(s, opts.Budget)
+
+	if ellipsizeCost(clusters) <= maxLen {
+		return s
+	}
+
+	ellipsis := opts.Ellipsis
+
+	if ellipsis == "" {
+		ellipsis = ellipsisDefault
+	}
+
+	available := maxLen - ellipsizeCost(ellipsizeClusters(ellipsis, opts.Budget))
+
+	if available < 1 {
+		return ellipsizeJoin(ellipsizeHead(clusters, maxLen, false))
+	}
+
+	var ext []ellipsizeCluster
+
+	if opts.KeepExtension {
+		if e := fileExtension(s); e != "" {
+			ext = ellipsizeClusters(e, opts.Budget)
+
+			if ellipsizeCost(ext) < available {
+				clusters = ellipsizeClusters(s[:len(s)-len(e)], opts.Budget)
+			} else {
+				ext = nil
+			}
+		}
+	}
+
+	switch opts.Mode {
+	case EllipsizeStart:
+		tail := ellipsizeTail(clusters, available-ellipsizeCost(ext), opts.WordBoundary)
+
+		return ellipsis + ellipsizeJoin(tail) + ellipsizeJoin(ext)
+
+	case EllipsizeMiddle:
+		// The tail is measured first, so the head takes whatever the word boundaries leave unused
+		tail := ellipsizeTail(clusters, (available-ellipsizeCost(ext))/2, opts.WordBoundary)
+		head := ellipsizeHead(clusters, available-ellipsizeCost(ext)-ellipsizeCost(tail), opts.WordBoundary)
+
+		return ellipsizeJoin(head) + ellipsis + ellipsizeJoin(tail) + ellipsizeJoin(ext)
+	}
+
+	head := ellipsizeHead(clusters, available-ellipsizeCost(ext), opts.WordBoundary)
+
+	return ellipsizeJoin(head) + ellipsis + ellipsizeJoin(ext)
+}
@@ -0,0 +1,42 @@
+package stringo
+
+import "testing"
+
+func TestEllipsize(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		maxLen         int
+		opts           EllipsizeOptions
+		expectedOutput string
+	}{
+		{"empty", "", 5, EllipsizeOptions{}, ""},
+		{"no limit", "The quick brown fox", 0, EllipsizeOptions{}, "The quick brown fox"},
+		{"fits", "The quick", 9, EllipsizeOptions{}, "The quick"},
+		{"end", "The quick brown fox", 12, EllipsizeOptions{}, "The quick b…"},
+		{"end at word boundary", "The quick brown fox", 12, EllipsizeOptions{WordBoundary: true}, "The quick…"},
+		{"long word falls back to hard cut", "Supercalifragilistic", 8, EllipsizeOptions{WordBoundary: true}, "Superca…"},
+		{"start", "The quick brown fox", 12, EllipsizeOptions{Mode: EllipsizeStart}, "…k brown fox"},
+		{"start at word boundary", "The quick brown fox", 12, EllipsizeOptions{Mode: EllipsizeStart, WordBoundary: true}, "…brown fox"},
+		{"middle", "abcdefghij", 7, EllipsizeOptions{Mode: EllipsizeMiddle}, "abc…hij"},
+		{"middle path", "/very/long/path/to/some/file.go", 20, EllipsizeOptions{Mode: EllipsizeMiddle, WordBoundary: true}, "/very/long/…/file.go"},
+		{"custom ellipsis counts", "The quick brown fox", 12, EllipsizeOptions{Ellipsis: "..."}, "The quick..."},
+		{"ellipsis larger than limit", "The quick brown fox", 2, EllipsizeOptions{Ellipsis: "..."}, "Th"},
+		{"keep extension", "a-very-long-file-name.txt", 15, EllipsizeOptions{KeepExtension: true}, "a-very-lon….txt"},
+		{"keep extension of path", "/tmp/a-very-long-file-name.txt", 15, EllipsizeOptions{Mode: EllipsizeMiddle, KeepExtension: true}, "/tmp/…-name.txt"},
+		{"hidden file has no extension", ".bash_history_backup", 10, EllipsizeOptions{KeepExtension: true}, ".bash_his…"},
+		{"keeps graphemes", graphemeFamily + graphemeFamily + graphemeFamily, 10, EllipsizeOptions{}, graphemeFamily + "…"},
+		{"width budget", "日本語のテキスト", 7, EllipsizeOptions{Budget: EllipsizeWidth}, "日本語…"},
+		{"rune budget", "日本語のテキスト", 7, EllipsizeOptions{}, "日本語のテキ…"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Ellipsize(tc.input, tc.maxLen, tc.opts)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q, %d,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.maxLen, tc.expectedOutput, r)
+			}
+		})
+	}
+}
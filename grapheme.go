@@ -0,0 +1,391 @@
+package stringo
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// graphemeBreak is the Grapheme_Cluster_Break property of a rune, as defined on UAX #29
+type graphemeBreak uint8
+
+const (
+	gbOther graphemeBreak = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+)
+
+// graphemePrepend holds the runes with Grapheme_Cluster_Break=Prepend, like Arabic number signs
+var graphemePrepend = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+		{Lo: 0x06dd, Hi: 0x06dd, Stride: 1},
+		{Lo: 0x070f, Hi: 0x070f, Stride: 1},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+		{Lo: 0x08e2, Hi: 0x08e2, Stride: 1},
+		{Lo: 0x0d4e, Hi: 0x0d4e, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x110bd, Hi: 0x110bd, Stride: 1},
+		{Lo: 0x110cd, Hi: 0x110cd, Stride: 1},
+		{Lo: 0x111c2, Hi: 0x111c3, Stride: 1},
+		{Lo: 0x1193f, Hi: 0x1193f, Stride: 1},
+		{Lo: 0x11941, Hi: 0x11941, Stride: 1},
+		{Lo: 0x11a3a, Hi: 0x11a3a, Stride: 1},
+		{Lo: 0x11a84, Hi: 0x11a89, Stride: 1},
+		{Lo: 0x11d46, Hi: 0x11d46, Stride: 1},
+	},
+}
+
+// extendedPictographic holds the runes with the Extended_Pictographic property, which are mostly emoji
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x2605, Stride: 1},
+		{Lo: 0x2607, Hi: 0x2612, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2685, Stride: 1},
+		{Lo: 0x2690, Hi: 0x2705, Stride: 1},
+		{Lo: 0x2708, Hi: 0x2712, Stride: 1},
+		{Lo: 0x2714, Hi: 0x2714, Stride: 1},
+		{Lo: 0x2716, Hi: 0x2716, Stride: 1},
+		{Lo: 0x271d, Hi: 0x271d, Stride: 1},
+		{Lo: 0x2721, Hi: 0x2721, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x2733, Hi: 0x2734, Stride: 1},
+		{Lo: 0x2744, Hi: 0x2744, Stride: 1},
+		{Lo: 0x2747, Hi: 0x2747, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2763, Hi: 0x2767, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27a1, Hi: 0x27a1, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
+		{Lo: 0x1f10d, Hi: 0x1f10f, Stride: 1},
+		{Lo: 0x1f12f, Hi: 0x1f12f, Stride: 1},
+		{Lo: 0x1f16c, Hi: 0x1f171, Stride: 1},
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1ad, Hi: 0x1f1e5, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f20f, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f23c, Hi: 0x1f23f, Stride: 1},
+		{Lo: 0x1f249, Hi: 0x1f3fa, Stride: 1},
+		{Lo: 0x1f400, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f546, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f774, Hi: 0x1f77f, Stride: 1},
+		{Lo: 0x1f7d5, Hi: 0x1f7ff, Stride: 1},
+		{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+		{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+		{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+		{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+		{Lo: 0x1f8ae, Hi: 0x1f8ff, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+}
+
+// graphemeProperty returns the Grapheme_Cluster_Break property of the given rune
+func graphemeProperty(r rune) graphemeBreak {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r < 0x20, r >= 0x7f && r <= 0x9f, r == 0xad:
+		return gbControl
+	case r < 0x300:
+		// Latin letters, digits and punctuation
+		return gbOther
+	case r == 0x200d:
+		return gbZWJ
+	case r == 0x200c, r >= 0x1f3fb && r <= 0x1f3ff, r >= 0xe0020 && r <= 0xe007f:
+		// Zero width non-joiner, emoji skin tone modifiers and emoji tags
+		return gbExtend
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return gbRegionalIndicator
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return gbL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return gbV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return gbT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return gbLV
+		}
+
+		return gbLVT
+	case unicode.Is(graphemePrepend, r):
+		return gbPrepend
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gbExtend
+	case unicode.Is(unicode.Mc, r):
+		return gbSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return gbControl
+	}
+
+	return gbOther
+}
+
+// graphemeClusterLen returns the length, in bytes, of the first grapheme cluster of the given string
+// It follows the extended grapheme cluster rules of UAX #29. See https://unicode.org/reports/tr29/#Grapheme_Cluster_Boundary_Rules
+func graphemeClusterLen(s string) int {
+	r, size := utf8.DecodeRuneInString(s)
+
+	if size == 0 {
+		return 0
+	}
+
+	var (
+		prev = graphemeProperty(r)
+		// emoji tracks an Extended_Pictographic followed by Extend*, and zwjEmoji that sequence followed by ZWJ (GB11)
+		emoji    = unicode.Is(extendedPictographic, r)
+		zwjEmoji = false
+		// regionalIndicators counts the sequence of regional indicators, which pair up as flags (GB12 and GB13)
+		regionalIndicators = 0
+		i                  = size
+	)
+
+	if prev == gbRegionalIndicator {
+		regionalIndicators = 1
+	}
+
+	for i < len(s) {
+		r, size = utf8.DecodeRuneInString(s[i:])
+		cur := graphemeProperty(r)
+		pict := unicode.Is(extendedPictographic, r)
+
+		join := false
+
+		switch {
+		case prev == gbCR && cur == gbLF: // GB3
+			join = true
+		case prev == gbControl || prev == gbCR || prev == gbLF: // GB4
+		case cur == gbControl || cur == gbCR || cur == gbLF: // GB5
+		case prev == gbL && (cur == gbL || cur == gbV || cur == gbLV || cur == gbLVT): // GB6
+			join = true
+		case (prev == gbLV || prev == gbV) && (cur == gbV || cur == gbT): // GB7
+			join = true
+		case (prev == gbLVT || prev == gbT) && cur == gbT: // GB8
+			join = true
+		case cur == gbExtend || cur == gbZWJ || cur == gbSpacingMark: // GB9 and GB9a
+			join = true
+		case prev == gbPrepend: // GB9b
+			join = true
+		case prev == gbZWJ && zwjEmoji && pict: // GB11
+			join = true
+		case prev == gbRegionalIndicator && cur == gbRegionalIndicator && regionalIndicators%2 == 1: // GB12 and GB13
+			join = true
+		}
+
+		if !join {
+			break
+		}
+
+		switch {
+		case pict:
+			emoji, zwjEmoji = true, false
+		case cur == gbExtend && emoji:
+		case cur == gbZWJ && emoji:
+			emoji, zwjEmoji = false, true
+		default:
+			emoji, zwjEmoji = false, false
+		}
+
+		if cur == gbRegionalIndicator {
+			regionalIndicators++
+		}
+
+		prev = cur
+		i += size
+	}
+
+	return i
+}
+
+// Graphemes splits the given string into grapheme clusters, which are the user-perceived characters
+// A cluster may have many runes, like an emoji with skin tone modifier, a flag, or a letter followed by combining accents.
+// Example: Graphemes("🇧🇷é") returns []string{"🇧🇷", "é"}, even if "é" is written as "e" plus a combining accent
+func Graphemes(s string) []string {
+	var clusters []string
+
+	for s != "" {
+		n := graphemeClusterLen(s)
+		clusters = append(clusters, s[:n])
+		s = s[n:]
+	}
+
+	return clusters
+}
+
+// GraphemeLen returns the number of grapheme clusters (user-perceived characters) of the given string
+// Example: GraphemeLen("👍🏽") returns 1, while utf8.RuneCountInString returns 2
+func GraphemeLen(s string) int {
+	count := 0
+
+	for s != "" {
+		s = s[graphemeClusterLen(s):]
+		count++
+	}
+
+	return count
+}
+
+// TruncateGraphemes limits the given string to maxLen grapheme clusters, never breaking a glyph
+// maxLen<1 means there's no maximum length
+// Example: TruncateGraphemes("👨‍👩‍👧 family", 1) returns "👨‍👩‍👧"
+func TruncateGraphemes(s string, maxLen int) string {
+	if s == "" || maxLen < 1 {
+		return s
+	}
+
+	i := 0
+
+	for n := 0; n < maxLen && i < len(s); n++ {
+		i += graphemeClusterLen(s[i:])
+	}
+
+	return s[:i]
+}
+
+// ReverseGraphemes returns the given string written backwards, keeping every grapheme cluster intact
+// Unlike Reverse, flags, emoji with modifiers and combining accents keep their looks
+// Example: ReverseGraphemes("👍🏽 ok") returns "ko 👍🏽"
+func ReverseGraphemes(s string) string {
+	clusters := Graphemes(s)
+
+	if len(clusters) < 2 {
+		return s
+	}
+
+	var sb strings.Builder
+
+	sb.Grow(len(s))
+
+	for i := len(clusters) - 1; i >= 0; i-- {
+		sb.WriteString(clusters[i])
+	}
+
+	return sb.String()
+}
+
+// runeWidth returns how many terminal columns the given rune takes
+func runeWidth(r rune) int {
+	switch graphemeProperty(r) {
+	case gbControl, gbCR, gbLF, gbExtend, gbZWJ:
+		return 0
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+
+	return 1
+}
+
+// graphemeWidth returns how many terminal columns the given grapheme cluster takes
+func graphemeWidth(cluster string) int {
+	r, _ := utf8.DecodeRuneInString(cluster)
+
+	// Flags and emoji with presentation selector are always wide
+	if graphemeProperty(r) == gbRegionalIndicator || strings.ContainsRune(cluster, 0xfe0f) {
+		return 2
+	}
+
+	return runeWidth(r)
+}
+
+// DisplayWidth returns how many columns the given string takes on a monospaced display, like terminals
+// East Asian wide and fullwidth characters, as well as emoji, take two columns. Combining marks and control characters take none.
+// Example: DisplayWidth("日本") returns 4
+func DisplayWidth(s string) int {
+	w := 0
+
+	for s != "" {
+		n := graphemeClusterLen(s)
+		w += graphemeWidth(s[:n])
+		s = s[n:]
+	}
+
+	return w
+}
+
+// TruncateWidth limits the given string to maxWidth columns of a monospaced display, never breaking a glyph
+// If a wide character doesn't fit in the last column, it's left out, so the result may be narrower than maxWidth.
+// maxWidth<1 means there's no maximum width
+// Example: TruncateWidth("日本語", 5) returns "日本"
+func TruncateWidth(s string, maxWidth int) string {
+	if s == "" || maxWidth < 1 {
+		return s
+	}
+
+	i, w := 0, 0
+
+	for i < len(s) {
+		n := graphemeClusterLen(s[i:])
+		cw := graphemeWidth(s[i : i+n])
+
+		if w+cw > maxWidth {
+			break
+		}
+
+		w += cw
+		i += n
+	}
+
+	return s[:i]
+}
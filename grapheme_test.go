@@ -0,0 +1,144 @@
+package stringo
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	graphemeThumbsUp = "\U0001F44D\U0001F3FD"                       // thumbs up with skin tone modifier
+	graphemeFamily   = "\U0001F468\u200D\U0001F469\u200D\U0001F467" // family, joined with ZWJ
+	graphemeFlag     = "\U0001F1E7\U0001F1F7"                       // regional indicators B and R
+	graphemeDecomp   = "e\u0301"                                    // "e" followed by combining acute accent
+	graphemeHangul   = "\u1100\u1161"                               // Hangul L and V jamos
+)
+
+func TestGraphemes(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		expectedOutput []string
+	}{
+		{"empty", "", nil},
+		{"ascii", "abc", []string{"a", "b", "c"}},
+		{"crlf", "a\r\nb", []string{"a", "\r\n", "b"}},
+		{"combining accent", "caf" + graphemeDecomp, []string{"c", "a", "f", graphemeDecomp}},
+		{"skin tone", graphemeThumbsUp + "!", []string{graphemeThumbsUp, "!"}},
+		{"zwj sequence", graphemeFamily + graphemeFamily, []string{graphemeFamily, graphemeFamily}},
+		{"flags pair up", graphemeFlag + "🇵🇹" + "🇺", []string{graphemeFlag, "🇵🇹", "🇺"}},
+		{"hangul jamos", graphemeHangul + "한", []string{graphemeHangul, "한"}},
+		{"emoji presentation selector", "❤️x", []string{"❤️", "x"}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Graphemes(tc.input)
+
+			if !reflect.DeepEqual(r, tc.expectedOutput) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+
+			if n := GraphemeLen(tc.input); n != len(tc.expectedOutput) {
+				t.Errorf("GraphemeLen has failed!\n\tInput: %q,\n\tExpected: %d, \n\tGot: %d", tc.input, len(tc.expectedOutput), n)
+			}
+		})
+	}
+}
+
+func TestTruncateGraphemes(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		maxLen         int
+		expectedOutput string
+	}{
+		{"empty", "", 3, ""},
+		{"no limit", "abc", 0, "abc"},
+		{"shorter than limit", "abc", 5, "abc"},
+		{"ascii", "abcdef", 3, "abc"},
+		{"keeps modifiers", graphemeThumbsUp + graphemeThumbsUp, 1, graphemeThumbsUp},
+		{"keeps zwj sequences", graphemeFamily + " family", 1, graphemeFamily},
+		{"keeps combining accents", "caf" + graphemeDecomp + "s", 4, "caf" + graphemeDecomp},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := TruncateGraphemes(tc.input, tc.maxLen)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestReverseGraphemes(t *testing.T) {
+	tcs := []defaultTestStruct{
+		{"empty", "", ""},
+		{"ascii", "Miguel", "leugiM"},
+		{"skin tone", graphemeThumbsUp + " ok", "ko " + graphemeThumbsUp},
+		{"flags", graphemeFlag + "🇵🇹", "🇵🇹" + graphemeFlag},
+		{"combining accent", "caf" + graphemeDecomp, graphemeDecomp + "fac"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := ReverseGraphemes(tc.input.(string))
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	tcs := []defaultTestStruct{
+		{"empty", "", 0},
+		{"ascii", "abc", 3},
+		{"accents", "caf" + graphemeDecomp, 4},
+		{"cjk", "日本語", 6},
+		{"fullwidth", "ＡＢ", 4},
+		{"emoji", graphemeThumbsUp, 2},
+		{"flag", graphemeFlag, 2},
+		{"zwj sequence", graphemeFamily, 2},
+		{"control characters", "a\tb", 2},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := DisplayWidth(tc.input.(string))
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %d, \n\tGot: %d", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		maxWidth       int
+		expectedOutput string
+	}{
+		{"empty", "", 3, ""},
+		{"no limit", "abc", 0, "abc"},
+		{"ascii", "abcdef", 4, "abcd"},
+		{"wide characters fit", "日本語", 4, "日本"},
+		{"wide character doesn't fit", "日本語", 5, "日本"},
+		{"mixed", "ab日本", 3, "ab"},
+		{"emoji", "ok" + graphemeThumbsUp + "!", 4, "ok" + graphemeThumbsUp},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := TruncateWidth(tc.input, tc.maxWidth)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ func Sha256Hash(s string) string {
 }
 
 // Truncate limits the length of a given string, trimming or not, according parameters
+// Observe that maxLen counts bytes, so multi-byte characters may be broken. Use TruncateGraphemes, TruncateWidth or Ellipsize for user-facing text.
 func Truncate(s string, maxLen int, trim bool) string {
 	if s == "" || maxLen < 1 {
 		return s
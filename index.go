@@ -0,0 +1,337 @@
+package stringo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// ErrIndexVersion means the serialized index was written by an incompatible version
+var ErrIndexVersion = errors.New("unsupported index version")
+
+// indexVersion is the version of the serialized index format
+const indexVersion = 1
+
+// indexDefaultNormalization is the pipeline spec used when IndexOptions.Normalize is nil
+const indexDefaultNormalization = "removeaccents|casefold|dedupspaces|trim"
+
+// indexWordThreshold is the minimum Jaro-Winkler similarity for a word to be highlighted as a match
+const indexWordThreshold = 0.85
+
+// IndexOptions parametrizes an Index. The zero value removes accents and folds case, comparing trigrams.
+type IndexOptions struct {
+	// Normalize is applied to documents and queries before indexing and searching. Default is "removeaccents|casefold|dedupspaces|trim"
+	Normalize *Pipeline `json:"normalize"`
+	// NGram is the size of the indexed substrings. Smaller sizes tolerate more typos, but match more noise. Default is 3
+	NGram int `json:"ngram"`
+}
+
+// IndexSpan is a highlighted part of a matching document, in byte offsets of its original text, as in Text[Start:End]
+type IndexSpan struct {
+	Start int
+	End   int
+}
+
+// IndexMatch is a search result
+type IndexMatch struct {
+	ID   string
+	Text string
+	// Score is the Sørensen-Dice coefficient of the query and document n-grams, from 0 to 1
+	Score float64
+	// Spans are the document words matching any query word, for highlighting
+	Spans []IndexSpan
+}
+
+// indexDoc is an indexed document, with its n-gram counts
+type indexDoc struct {
+	text  string
+	grams map[string]int
+	total int
+}
+
+// Index is an in-memory fuzzy search index over short strings, like product or customer names
+// Documents are normalized and split into n-grams, so queries match despite typos, accents and case.
+// It's safe for concurrent use: searches run in parallel, and writes wait for them.
+type Index struct {
+	mutex    sync.RWMutex
+	opts     IndexOptions
+	docs     map[string]indexDoc
+	postings map[string]map[string]int
+}
+
+// NewIndex returns an empty index
+func NewIndex(opts IndexOptions) (*Index, error) {
+	if opts.NGram < 0 {
+		return nil, fmt.Errorf("invalid index n-gram size %d", opts.NGram)
+	}
+
+	if opts.NGram == 0 {
+		opts.NGram = 3
+	}
+
+	if opts.Normalize == nil {
+		p, err := ParsePipeline(indexDefaultNormalization)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Normalize = p
+	}
+
+	return &Index{opts: opts, docs: map[string]indexDoc{}, postings: map[string]map[string]int{}}, nil
+}
+
+// grams splits the given normalized string into n-grams, padded with spaces so word starts and ends weight more
+func (ix *Index) grams(s string) (map[string]int, int) {
+	r := []rune(" " + s + " ")
+	n := ix.opts.NGram
+	grams := map[string]int{}
+	total := 0
+
+	if len(r) < n {
+		grams[string(r)]++
+		return grams, 1
+	}
+
+	for i := 0; i+n <= len(r); i++ {
+		grams[string(r[i:i+n])]++
+		total++
+	}
+
+	return grams, total
+}
+
+// remove drops a document from the postings. The caller must hold the write lock.
+func (ix *Index) remove(id string) bool {
+	doc, ok := ix.docs[id]
+
+	if !ok {
+		return false
+	}
+
+	for g := range doc.grams {
+		delete(ix.postings[g], id)
+
+		if len(ix.postings[g]) == 0 {
+			delete(ix.postings, g)
+		}
+	}
+
+	delete(ix.docs, id)
+
+	return true
+}
+
+// Add indexes the given text under the given id, replacing any document with the same id
+// It fails if normalization fails, leaving the index untouched.
+func (ix *Index) Add(id, text string) error {
+	normalized, err := ix.opts.Normalize.Run(text)
+	if err != nil {
+		return fmt.Errorf("can't normalize document %q: %w", id, err)
+	}
+
+	grams, total := ix.grams(normalized)
+
+	ix.mutex.Lock()
+	defer ix.mutex.Unlock()
+
+	ix.remove(id)
+	ix.docs[id] = indexDoc{text: text, grams: grams, total: total}
+
+	for g, count := range grams {
+		if ix.postings[g] == nil {
+			ix.postings[g] = map[string]int{}
+		}
+
+		ix.postings[g][id] = count
+	}
+
+	return nil
+}
+
+// Remove drops the document with the given id, returning false if there's none
+func (ix *Index) Remove(id string) bool {
+	ix.mutex.Lock()
+	defer ix.mutex.Unlock()
+
+	return ix.remove(id)
+}
+
+// Len returns how many documents are indexed
+func (ix *Index) Len() int {
+	ix.mutex.RLock()
+	defer ix.mutex.RUnlock()
+
+	return len(ix.docs)
+}
+
+// Search returns up to k documents matching the given query, best first. k<1 means all matching documents.
+// Documents with the same score are sorted by id. Queries which fail normalization match nothing.
+// Example: Search("jon smyth", 5) finds "John Smith", highlighting both words
+func (ix *Index) Search(query string, k int) []IndexMatch {
+	normalized, err := ix.opts.Normalize.Run(query)
+	if err != nil || normalized == "" {
+		return nil
+	}
+
+	queryGrams, queryTotal := ix.grams(normalized)
+
+	ix.mutex.RLock()
+
+	shared := map[string]int{}
+
+	for g, qc := range queryGrams {
+		for id, dc := range ix.postings[g] {
+			shared[id] += minInt(qc, dc)
+		}
+	}
+
+	matches := make([]IndexMatch, 0, len(shared))
+
+	for id, count := range shared {
+		doc := ix.docs[id]
+
+		matches = append(matches, IndexMatch{
+			ID:    id,
+			Text:  doc.text,
+			Score: 2 * float64(count) / float64(queryTotal+doc.total),
+		})
+	}
+
+	ix.mutex.RUnlock()
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+
+		return matches[i].ID < matches[j].ID
+	})
+
+	if k > 0 && len(matches) > k {
+		matches = matches[:k]
+	}
+
+	queryWords := strings.Fields(normalized)
+
+	for i := range matches {
+		matches[i].Spans = ix.highlight(matches[i].Text, queryWords)
+	}
+
+	return matches
+}
+
+// highlight returns the spans of the text words which look like any of the normalized query words
+func (ix *Index) highlight(text string, queryWords []string) []IndexSpan {
+	var spans []IndexSpan
+
+	start := -1
+
+	for i, r := range text + " " {
+		if !unicode.IsSpace(r) {
+			if start < 0 {
+				start = i
+			}
+
+			continue
+		}
+
+		if start < 0 {
+			continue
+		}
+
+		word, err := ix.opts.Normalize.Run(text[start:i])
+
+		if err == nil && word != "" {
+			for _, q := range queryWords {
+				if strings.HasPrefix(word, q) || JaroWinkler(word, q) >= indexWordThreshold {
+					spans = append(spans, IndexSpan{Start: start, End: i})
+					break
+				}
+			}
+		}
+
+		start = -1
+	}
+
+	return spans
+}
+
+// serializedIndex is the JSON representation of an index. Only documents are written, n-grams are rebuilt when read.
+type serializedIndex struct {
+	Version int               `json:"version"`
+	Options IndexOptions      `json:"options"`
+	Docs    []serializedEntry `json:"docs"`
+}
+
+// serializedEntry is a serialized document
+type serializedEntry struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+// Write implements io.Writer
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+
+	return n, err
+}
+
+// WriteTo implements io.WriterTo, writing the index as JSON, so it can be saved to disk and loaded with ReadIndex
+// Custom normalization steps must be registered again, with RegisterPipelineStep, before reading the index back.
+func (ix *Index) WriteTo(w io.Writer) (int64, error) {
+	ix.mutex.RLock()
+
+	si := serializedIndex{Version: indexVersion, Options: ix.opts, Docs: make([]serializedEntry, 0, len(ix.docs))}
+
+	for id, doc := range ix.docs {
+		si.Docs = append(si.Docs, serializedEntry{ID: id, Text: doc.text})
+	}
+
+	ix.mutex.RUnlock()
+
+	sort.Slice(si.Docs, func(i, j int) bool { return si.Docs[i].ID < si.Docs[j].ID })
+
+	cw := &countingWriter{w: w}
+	err := json.NewEncoder(cw).Encode(si)
+
+	return cw.n, err
+}
+
+// ReadIndex reads an index written by Index.WriteTo
+func ReadIndex(r io.Reader) (*Index, error) {
+	var si serializedIndex
+
+	if err := json.NewDecoder(r).Decode(&si); err != nil {
+		return nil, fmt.Errorf("can't decode index: %w", err)
+	}
+
+	if si.Version != indexVersion {
+		return nil, fmt.Errorf("%w: %d", ErrIndexVersion, si.Version)
+	}
+
+	ix, err := NewIndex(si.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range si.Docs {
+		if err := ix.Add(e.ID, e.Text); err != nil {
+			return nil, err
+		}
+	}
+
+	return ix, nil
+}
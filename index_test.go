@@ -0,0 +1,152 @@
+package stringo
+
+import (
+	"bytes"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func newTestIndex(t *testing.T) *Index {
+	ix, err := NewIndex(IndexOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs := map[string]string{
+		"1": "John Smith",
+		"2": "Joana Smithers",
+		"3": "Mary Jones",
+		"4": "José Conceição",
+		"5": "Café Coração",
+	}
+
+	for id, text := range docs {
+		if err := ix.Add(id, text); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return ix
+}
+
+func TestIndexSearch(t *testing.T) {
+	ix := newTestIndex(t)
+
+	tcs := []struct {
+		summary       string
+		query         string
+		k             int
+		expectedIDs   []string
+		expectedSpans []IndexSpan
+	}{
+		{"empty query", "", 5, nil, nil},
+		{"no match", "xyzzy", 5, []string{}, nil},
+		{"exact", "John Smith", 1, []string{"1"}, []IndexSpan{{0, 4}, {5, 10}}},
+		{"typos", "jon smyth", 1, []string{"1"}, []IndexSpan{{0, 4}, {5, 10}}},
+		{"accents and case", "JOSE CONCEICAO", 1, []string{"4"}, []IndexSpan{{0, 5}, {6, 17}}},
+		{"prefix", "cora", 1, []string{"5"}, []IndexSpan{{6, 15}}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := ix.Search(tc.query, tc.k)
+
+			if tc.expectedIDs == nil {
+				if r != nil {
+					t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected no results, \n\tGot: %v", tc.query, r)
+				}
+
+				return
+			}
+
+			ids := []string{}
+
+			for _, m := range r {
+				ids = append(ids, m.ID)
+			}
+
+			if !reflect.DeepEqual(ids, tc.expectedIDs) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v", tc.query, tc.expectedIDs, ids)
+			}
+
+			if len(r) > 0 && !reflect.DeepEqual(r[0].Spans, tc.expectedSpans) {
+				t.Errorf("Spans have failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v", tc.query, tc.expectedSpans, r[0].Spans)
+			}
+		})
+	}
+}
+
+func TestIndexAddRemove(t *testing.T) {
+	ix := newTestIndex(t)
+
+	if !ix.Remove("1") || ix.Remove("1") {
+		t.Error("Remove has failed")
+	}
+
+	if r := ix.Search("John Smith", 1); len(r) == 0 || r[0].ID != "2" {
+		t.Errorf("Search after Remove has failed, got: %v", r)
+	}
+
+	if err := ix.Add("2", "Mary Smith"); err != nil {
+		t.Fatal(err)
+	}
+
+	if r := ix.Search("Joana", 0); len(r) > 0 && r[0].Score > 0.5 {
+		t.Errorf("Replacing a document has failed, got: %v", r)
+	}
+
+	if ix.Len() != 4 {
+		t.Errorf("Len has failed!\n\tExpected: 4, \n\tGot: %d", ix.Len())
+	}
+}
+
+func TestIndexSerialization(t *testing.T) {
+	ix := newTestIndex(t)
+
+	var buf bytes.Buffer
+
+	n, err := ix.WriteTo(&buf)
+	if err != nil || n != int64(buf.Len()) {
+		t.Fatalf("WriteTo has failed: %d, %v", n, err)
+	}
+
+	read, err := ReadIndex(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(read.Search("jon smyth", 0), ix.Search("jon smyth", 0)) {
+		t.Error("Search on the read index differs")
+	}
+
+	if _, err := ReadIndex(bytes.NewBufferString(`{"version": 99}`)); err == nil {
+		t.Error("ReadIndex should fail on unknown versions")
+	}
+}
+
+func TestIndexConcurrency(t *testing.T) {
+	ix := newTestIndex(t)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			ix.Search("smith", 3)
+		}()
+
+		go func(i int) {
+			defer wg.Done()
+			_ = ix.Add(string(rune('a'+i)), "Someone Smith")
+		}(i)
+	}
+
+	wg.Wait()
+
+	if ix.Len() != 13 {
+		t.Errorf("Test has failed!\n\tExpected: 13, \n\tGot: %d", ix.Len())
+	}
+}
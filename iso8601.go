@@ -0,0 +1,526 @@
+package stringo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrISO8601 means a string isn't a valid, or supported, ISO 8601 date, duration or interval
+var ErrISO8601 = errors.New("invalid ISO 8601 value")
+
+// ISODuration is an ISO 8601 duration, like "P1Y2M10DT2H30M"
+// Calendar components are kept apart, since years, months and days vary in length.
+type ISODuration struct {
+	Negative    bool
+	Years       int
+	Months      int
+	Weeks       int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// isoDurationDesignators are the designators of the date and time parts of a duration, in order
+var isoDurationDesignators = [2]string{"YMWD", "HMS"}
+
+// ParseISODuration parses an ISO 8601 duration, like "P1Y2M10DT2H30M", "PT0.5S", "P2W" or "-P1D"
+// Only seconds may have a fraction, with a period or a comma.
+func ParseISODuration(s string) (ISODuration, error) {
+	var d ISODuration
+
+	rest := s
+
+	if strings.HasPrefix(rest, "-") {
+		d.Negative, rest = true, rest[1:]
+	}
+
+	if !strings.HasPrefix(rest, "P") || rest == "P" {
+		return ISODuration{}, fmt.Errorf("%w: duration %q", ErrISO8601, s)
+	}
+
+	rest = rest[1:]
+	datePart, timePart, hasTime := isoCut(rest, "T")
+
+	if hasTime && timePart == "" {
+		return ISODuration{}, fmt.Errorf("%w: duration %q has an empty time part", ErrISO8601, s)
+	}
+
+	for i, part := range []string{datePart, timePart} {
+		designators := isoDurationDesignators[i]
+
+		for part != "" {
+			n := 0
+
+			for n < len(part) && (part[n] >= '0' && part[n] <= '9' || part[n] == '.' || part[n] == ',') {
+				n++
+			}
+
+			if n == 0 || n == len(part) {
+				return ISODuration{}, fmt.Errorf("%w: duration %q", ErrISO8601, s)
+			}
+
+			number, designator := strings.Replace(part[:n], ",", ".", 1), part[n]
+			next := strings.IndexByte(designators, designator)
+
+			if next < 0 {
+				return ISODuration{}, fmt.Errorf("%w: duration %q has misplaced %q", ErrISO8601, s, designator)
+			}
+
+			// designators must come in order, and each only once
+			designators = designators[next+1:]
+			part = part[n+1:]
+
+			if strings.Contains(number, ".") {
+				if designator != 'S' || i == 0 {
+					return ISODuration{}, fmt.Errorf("%w: duration %q has a fraction other than seconds", ErrISO8601, s)
+				}
+
+				f, err := strconv.ParseFloat(number, 64)
+				if err != nil {
+					return ISODuration{}, fmt.Errorf("%w: duration %q", ErrISO8601, s)
+				}
+
+				d.Seconds = int(f)
+				d.Nanoseconds = int((f-float64(d.Seconds))*1e9 + 0.5)
+
+				continue
+			}
+
+			v, err := strconv.Atoi(number)
+			if err != nil {
+				return ISODuration{}, fmt.Errorf("%w: duration %q", ErrISO8601, s)
+			}
+
+			switch {
+			case i == 0 && designator == 'Y':
+				d.Years = v
+			case i == 0 && designator == 'M':
+				d.Months = v
+			case designator == 'W':
+				d.Weeks = v
+			case designator == 'D':
+				d.Days = v
+			case designator == 'H':
+				d.Hours = v
+			case designator == 'M':
+				d.Minutes = v
+			case designator == 'S':
+				d.Seconds = v
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// ISODurationFrom returns the ISO 8601 duration for a time.Duration, in hours, minutes and seconds
+// Days aren't used, since they may not have 24 hours. I.E: 26 hours are "PT26H"
+func ISODurationFrom(td time.Duration) ISODuration {
+	var d ISODuration
+
+	if td < 0 {
+		d.Negative, td = true, -td
+	}
+
+	d.Hours = int(td / time.Hour)
+	d.Minutes = int(td % time.Hour / time.Minute)
+	d.Seconds = int(td % time.Minute / time.Second)
+	d.Nanoseconds = int(td % time.Second)
+
+	return d
+}
+
+// String returns the duration in ISO 8601 notation, like "P1Y2M10DT2H30M", omitting zero components. Zero is "PT0S"
+func (d ISODuration) String() string {
+	var b strings.Builder
+
+	if d.Negative {
+		b.WriteString("-")
+	}
+
+	b.WriteString("P")
+
+	for _, c := range []struct {
+		value      int
+		designator string
+	}{{d.Years, "Y"}, {d.Months, "M"}, {d.Weeks, "W"}, {d.Days, "D"}} {
+		if c.value != 0 {
+			b.WriteString(strconv.Itoa(c.value) + c.designator)
+		}
+	}
+
+	if d.Hours == 0 && d.Minutes == 0 && d.Seconds == 0 && d.Nanoseconds == 0 {
+		if b.Len() <= 2 {
+			return "PT0S"
+		}
+
+		return b.String()
+	}
+
+	b.WriteString("T")
+
+	if d.Hours != 0 {
+		b.WriteString(strconv.Itoa(d.Hours) + "H")
+	}
+
+	if d.Minutes != 0 {
+		b.WriteString(strconv.Itoa(d.Minutes) + "M")
+	}
+
+	if d.Seconds != 0 || d.Nanoseconds != 0 {
+		b.WriteString(strconv.Itoa(d.Seconds))
+
+		if d.Nanoseconds != 0 {
+			b.WriteString("." + strings.TrimRight(datePad(d.Nanoseconds, 9), "0"))
+		}
+
+		b.WriteString("S")
+	}
+
+	return b.String()
+}
+
+// AddTo returns the time plus the duration. Years, months, weeks and days are added by calendar, as time.AddDate does.
+func (d ISODuration) AddTo(t time.Time) time.Time {
+	sign := 1
+
+	if d.Negative {
+		sign = -1
+	}
+
+	t = t.AddDate(sign*d.Years, sign*d.Months, sign*(d.Weeks*7+d.Days))
+
+	return t.Add(time.Duration(sign) * (time.Duration(d.Hours)*time.Hour + time.Duration(d.Minutes)*time.Minute +
+		time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanoseconds)))
+}
+
+// isoNumber parses exactly n digits at the start of s
+func isoNumber(s string, n int) (int, string, bool) {
+	v, read, ok := dateDigits(s, n, n)
+
+	return v, s[read:], ok
+}
+
+// isoWeekStart returns the Monday of the first ISO week of the year, the one with the year's first Thursday
+func isoWeekStart(year int, loc *time.Location) time.Time {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+
+	return jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+}
+
+// parseISODay parses the date part of an ISO 8601 date: calendar, ordinal or week date, extended or basic
+func parseISODay(s string, loc *time.Location) (time.Time, bool) {
+	year, rest, ok := isoNumber(s, 4)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	extended := strings.HasPrefix(rest, "-")
+
+	if extended {
+		rest = rest[1:]
+	}
+
+	// week dates: 2026-W42-6, 2026W426, 2026-W42
+	if strings.HasPrefix(rest, "W") {
+		week, r, ok := isoNumber(rest[1:], 2)
+		if !ok {
+			return time.Time{}, false
+		}
+
+		weekday := 1
+
+		if r != "" {
+			if extended {
+				if !strings.HasPrefix(r, "-") {
+					return time.Time{}, false
+				}
+
+				r = r[1:]
+			}
+
+			if weekday, r, ok = isoNumber(r, 1); !ok || r != "" || weekday < 1 || weekday > 7 {
+				return time.Time{}, false
+			}
+		}
+
+		t := isoWeekStart(year, loc).AddDate(0, 0, (week-1)*7+weekday-1)
+
+		if y, w := t.ISOWeek(); week < 1 || y != year || w != week {
+			return time.Time{}, false
+		}
+
+		return t, true
+	}
+
+	// ordinal dates: 2026-290, 2026290
+	if len(rest) == 3 {
+		yearDay, _, ok := isoNumber(rest, 3)
+		t := time.Date(year, time.January, yearDay, 0, 0, 0, 0, loc)
+
+		if !ok || yearDay < 1 || t.Year() != year {
+			return time.Time{}, false
+		}
+
+		return t, true
+	}
+
+	// calendar dates: 2026-10-17, 20261017
+	month, rest, ok := isoNumber(rest, 2)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	if extended {
+		if !strings.HasPrefix(rest, "-") {
+			return time.Time{}, false
+		}
+
+		rest = rest[1:]
+	}
+
+	day, rest, ok := isoNumber(rest, 2)
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+
+	if !ok || rest != "" || month < 1 || month > 12 || day < 1 || t.Day() != day {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// isoCut splits s around the first sep, as strings.Cut does
+func isoCut(s, sep string) (string, string, bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+
+	return s, "", false
+}
+
+// parseISOClock parses the time of day of an ISO 8601 date, like "14:05:09.250" or "140509", returning it as a duration since midnight
+func parseISOClock(s string) (time.Duration, bool) {
+	hour, rest, ok := isoNumber(s, 2)
+	if !ok || hour > 23 {
+		return 0, false
+	}
+
+	clock := time.Duration(hour) * time.Hour
+	extended := strings.HasPrefix(rest, ":")
+
+	for _, unit := range []time.Duration{time.Minute, time.Second} {
+		if rest == "" || rest[0] == '.' || rest[0] == ',' {
+			break
+		}
+
+		if extended {
+			if !strings.HasPrefix(rest, ":") {
+				return 0, false
+			}
+
+			rest = rest[1:]
+		}
+
+		var v int
+
+		if v, rest, ok = isoNumber(rest, 2); !ok || v > 59 {
+			return 0, false
+		}
+
+		clock += time.Duration(v) * unit
+	}
+
+	if rest != "" {
+		if rest[0] != '.' && rest[0] != ',' {
+			return 0, false
+		}
+
+		fraction, n, ok := dateDigits(rest[1:], 1, 9)
+		if !ok || n != len(rest)-1 {
+			return 0, false
+		}
+
+		clock += time.Duration(fraction * pow10(9-n))
+	}
+
+	return clock, true
+}
+
+// ParseISODate parses an ISO 8601 date, with optional time of day and zone
+// Dates may be calendar dates, like "2026-10-17", ordinal dates, like "2026-290", or week dates, like "2026-W42-6",
+// in extended or basic notation, as in "20261017". Times follow a "T", like "T14:05:09.250-03:00", and default to UTC.
+func ParseISODate(s string) (time.Time, error) {
+	day, clock, hasClock := isoCut(s, "T")
+
+	t, ok := parseISODay(day, time.UTC)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: date %q", ErrISO8601, s)
+	}
+
+	if !hasClock {
+		return t, nil
+	}
+
+	// the zone starts at Z, + or -
+	zone := ""
+
+	if i := strings.IndexAny(clock, "Z+-"); i >= 0 {
+		clock, zone = clock[:i], clock[i:]
+	}
+
+	sinceMidnight, ok := parseISOClock(clock)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: date %q has an invalid time", ErrISO8601, s)
+	}
+
+	loc := time.UTC
+
+	if zone != "" && zone != "Z" {
+		width := map[int]int{3: 1, 5: 2, 6: 3}[len(zone)]
+		offset, n, ok := dateZoneOffsetValue(zone, width)
+
+		if !ok || n != len(zone) {
+			return time.Time{}, fmt.Errorf("%w: date %q has an invalid zone", ErrISO8601, s)
+		}
+
+		loc = time.FixedZone("", offset)
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).Add(sinceMidnight), nil
+}
+
+// ParseISOInterval parses an ISO 8601 interval: start and end, as in "2026-01-01/2026-02-01",
+// start and duration, as in "2026-01-01/P1M", or duration and end, as in "P1M/2026-02-01". Recurring intervals aren't supported.
+func ParseISOInterval(s string) (time.Time, time.Time, error) {
+	first, second, ok := isoCut(s, "/")
+
+	if !ok {
+		first, second, ok = isoCut(s, "--")
+	}
+
+	if !ok || strings.HasPrefix(first, "R") {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: interval %q", ErrISO8601, s)
+	}
+
+	if strings.HasPrefix(first, "P") {
+		d, err := ParseISODuration(first)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+
+		end, err := ParseISODate(second)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+
+		d.Negative = !d.Negative
+
+		return d.AddTo(end), end, nil
+	}
+
+	start, err := ParseISODate(first)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if strings.HasPrefix(second, "P") {
+		d, err := ParseISODuration(second)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+
+		return start, d.AddTo(start), nil
+	}
+
+	end, err := ParseISODate(second)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: interval %q ends before it starts", ErrISO8601, s)
+	}
+
+	return start, end, nil
+}
+
+// FormatISOWeekDate formats the date as an ISO 8601 week date, like "2026-W42-6", where Monday is 1 and Sunday is 7
+// Observe the ISO week year may differ from the calendar year, around New Year.
+func FormatISOWeekDate(t time.Time) string {
+	year, week := t.ISOWeek()
+
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, (int(t.Weekday())+6)%7+1)
+}
+
+// FormatISOOrdinalDate formats the date as an ISO 8601 ordinal date, like "2026-290"
+func FormatISOOrdinalDate(t time.Time) string {
+	return fmt.Sprintf("%04d-%03d", t.Year(), t.YearDay())
+}
+
+// ISODateReformat gets an ISO 8601 date, calendar, ordinal or week date, and formats it according newLayout, in DateDialectHandy notation
+// It returns an empty string if the date is invalid.
+// Example: ISODateReformat("2026-W42-6", "dd/mm/yyyy") returns "17/10/2026"
+func ISODateReformat(d, newLayout string) string {
+	t, err := ParseISODate(d)
+	if err != nil {
+		return ""
+	}
+
+	return DateTimeAsString(t, newLayout)
+}
+
+// DateToISOWeek gets a date string in the given layout, in DateDialectHandy notation, and returns it as an ISO 8601 week date
+// Example: DateToISOWeek("17/10/2026", "dd/mm/yyyy") returns "2026-W42-6"
+func DateToISOWeek(d, layout string) string {
+	t, err := ParseDate(d, layout, ParseDateOptions{})
+	if err != nil {
+		return ""
+	}
+
+	return FormatISOWeekDate(t)
+}
+
+// DateToISOOrdinal gets a date string in the given layout, in DateDialectHandy notation, and returns it as an ISO 8601 ordinal date
+// Example: DateToISOOrdinal("17/10/2026", "dd/mm/yyyy") returns "2026-290"
+func DateToISOOrdinal(d, layout string) string {
+	t, err := ParseDate(d, layout, ParseDateOptions{})
+	if err != nil {
+		return ""
+	}
+
+	return FormatISOOrdinalDate(t)
+}
+
+// ISOIntervalReformat gets an ISO 8601 interval and returns its start and end formatted according newLayout, in DateDialectHandy notation
+// It returns empty strings if the interval is invalid.
+// Example: ISOIntervalReformat("2026-01-31/P1M", "dd/mm/yyyy") returns "31/01/2026", "03/03/2026"
+func ISOIntervalReformat(s, newLayout string) (string, string) {
+	start, end, err := ParseISOInterval(s)
+	if err != nil {
+		return "", ""
+	}
+
+	return DateTimeAsString(start, newLayout), DateTimeAsString(end, newLayout)
+}
+
+// ISODurationAdd gets a date string in the given layout, in DateDialectHandy notation, adds an ISO 8601 duration to it,
+// and returns the result in the same layout. It returns an empty string if the date or the duration are invalid.
+// Example: ISODurationAdd("17/10/2026", "dd/mm/yyyy", "P1M2D") returns "19/11/2026"
+func ISODurationAdd(d, layout, duration string) string {
+	t, err := ParseDate(d, layout, ParseDateOptions{})
+	if err != nil {
+		return ""
+	}
+
+	iso, err := ParseISODuration(duration)
+	if err != nil {
+		return ""
+	}
+
+	return DateTimeAsString(iso.AddTo(t), layout)
+}
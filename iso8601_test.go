@@ -0,0 +1,161 @@
+package stringo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestISODuration(t *testing.T) {
+	tcs := []struct {
+		input          string
+		expectedOutput ISODuration
+		expectedString string
+	}{
+		{"P1Y2M10DT2H30M", ISODuration{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}, "P1Y2M10DT2H30M"},
+		{"P2W", ISODuration{Weeks: 2}, "P2W"},
+		{"PT0.5S", ISODuration{Nanoseconds: 500000000}, "PT0.5S"},
+		{"PT1,25S", ISODuration{Seconds: 1, Nanoseconds: 250000000}, "PT1.25S"},
+		{"-P1D", ISODuration{Negative: true, Days: 1}, "-P1D"},
+		{"PT36H", ISODuration{Hours: 36}, "PT36H"},
+		{"P0D", ISODuration{}, "PT0S"},
+		{"P1M", ISODuration{Months: 1}, "P1M"},
+		{"PT1M", ISODuration{Minutes: 1}, "PT1M"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			r, err := ParseISODuration(tc.input)
+
+			if err != nil || r != tc.expectedOutput || r.String() != tc.expectedString {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %+v %q, \n\tGot: %+v %q, %v", tc.input, tc.expectedOutput, tc.expectedString, r, r.String(), err)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "P", "PT", "1D", "P1", "PD", "P1D2Y", "P1H", "PT1D", "P1.5D", "P1DT", "P1Y1Y"} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseISODuration(input); !errors.Is(err, ErrISO8601) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v", input, ErrISO8601, err)
+			}
+		})
+	}
+}
+
+func TestISODurationConversions(t *testing.T) {
+	if r := ISODurationFrom(26*time.Hour + 90*time.Second + 5*time.Millisecond).String(); r != "PT26H1M30.005S" {
+		t.Errorf("Test has failed!\n\tExpected: %q, \n\tGot: %q", "PT26H1M30.005S", r)
+	}
+
+	if r := ISODurationFrom(-time.Minute).String(); r != "-PT1M" {
+		t.Errorf("Test has failed!\n\tExpected: %q, \n\tGot: %q", "-PT1M", r)
+	}
+
+	start := time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC)
+	d := ISODuration{Months: 1, Days: 1, Hours: 2}
+
+	if r := d.AddTo(start); !r.Equal(time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Test has failed!\n\tExpected: %v, \n\tGot: %v", time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC), r)
+	}
+}
+
+func TestParseISODate(t *testing.T) {
+	sp := time.FixedZone("", -3*60*60)
+
+	tcs := []struct {
+		input          string
+		expectedOutput time.Time
+	}{
+		{"2026-10-17", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"20261017", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"2026-290", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"2026290", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"2026-W42-6", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"2026W426", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"2026-W42", time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+		{"2026-W01-1", time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{"2020-W53-7", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"2024-366", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"2026-10-17T14:05", time.Date(2026, 10, 17, 14, 5, 0, 0, time.UTC)},
+		{"2026-10-17T14:05:09.25Z", time.Date(2026, 10, 17, 14, 5, 9, 250000000, time.UTC)},
+		{"2026-10-17T14:05:09-03:00", time.Date(2026, 10, 17, 14, 5, 9, 0, sp)},
+		{"20261017T140509-0300", time.Date(2026, 10, 17, 14, 5, 9, 0, sp)},
+		{"2026-290T14-03", time.Date(2026, 10, 17, 14, 0, 0, 0, sp)},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			r, err := ParseISODate(tc.input)
+
+			if err != nil || !r.Equal(tc.expectedOutput) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v, %v", tc.input, tc.expectedOutput, r, err)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "2026", "2026-13-01", "2026-02-30", "2025-366", "2026-W54-1", "2025-W53-1", "2026-W42-8", "2026-10-17T25:00", "2026-10-17T14:05+3", "17/10/2026"} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseISODate(input); !errors.Is(err, ErrISO8601) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v", input, ErrISO8601, err)
+			}
+		})
+	}
+}
+
+func TestISOReformat(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		fn             func() string
+		expectedOutput string
+	}{
+		{"week date to layout", func() string { return ISODateReformat("2026-W42-6", "dd/mm/yyyy") }, "17/10/2026"},
+		{"ordinal date to layout", func() string { return ISODateReformat("2026-290", "yyyy-mm-dd") }, "2026-10-17"},
+		{"invalid iso date", func() string { return ISODateReformat("2026-W60", "yyyy-mm-dd") }, ""},
+		{"layout to week date", func() string { return DateToISOWeek("17/10/2026", "dd/mm/yyyy") }, "2026-W42-6"},
+		{"week year differs", func() string { return DateToISOWeek("2027-01-01", "yyyy-mm-dd") }, "2026-W53-5"},
+		{"layout to ordinal date", func() string { return DateToISOOrdinal("17/10/2026", "dd/mm/yyyy") }, "2026-290"},
+		{"invalid date", func() string { return DateToISOOrdinal("17/10", "dd/mm/yyyy") }, ""},
+		{"add duration", func() string { return ISODurationAdd("17/10/2026", "dd/mm/yyyy", "P1M2D") }, "19/11/2026"},
+		{"add negative duration", func() string { return ISODurationAdd("17/10/2026 10:00", "dd/mm/yyyy hh24:nn", "-PT12H") }, "16/10/2026 22:00"},
+		{"add invalid duration", func() string { return ISODurationAdd("17/10/2026", "dd/mm/yyyy", "1 month") }, ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := tc.fn()
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tExpected: %q, \n\tGot: %q", tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestISOInterval(t *testing.T) {
+	tcs := []struct {
+		input         string
+		expectedStart string
+		expectedEnd   string
+	}{
+		{"2026-01-01/2026-02-01", "01/01/2026", "01/02/2026"},
+		{"2026-01-31/P1M", "31/01/2026", "03/03/2026"},
+		{"P1M/2026-02-01", "01/01/2026", "01/02/2026"},
+		{"2026-W01-1/P1W", "29/12/2025", "05/01/2026"},
+		{"2026-01-01T10:00Z/PT90M", "01/01/2026", "01/01/2026"},
+		{"2026-01-01--2026-01-10", "01/01/2026", "10/01/2026"},
+		{"2026-02-01/2026-01-01", "", ""},
+		{"R5/2026-01-01/P1D", "", ""},
+		{"2026-01-01", "", ""},
+		{"P1D/P2D", "", ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			start, end := ISOIntervalReformat(tc.input, "dd/mm/yyyy")
+
+			if start != tc.expectedStart || end != tc.expectedEnd {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, %q \n\tGot: %q, %q", tc.input, tc.expectedStart, tc.expectedEnd, start, end)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package stringo
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -20,54 +21,174 @@ const (
 	ChkPersonNameTooShort ChkPersonNameResult = 3
 	// ChkPersonNameTooSimple The name rule requires that at least one word
 	ChkPersonNameTooSimple ChkPersonNameResult = 4
+	// ChkPersonNameRepeatedLetters means a letter is repeated in a row more than PersonNameRules.MaxRepeatedLetters times
+	ChkPersonNameRepeatedLetters ChkPersonNameResult = 5
+	// ChkPersonNameForbiddenWord means the name has a word from PersonNameRules.ForbiddenWords
+	ChkPersonNameForbiddenWord ChkPersonNameResult = 6
 )
 
-// ChkPersonName returns true if the name contains at least two words, one >= 3 chars and one >=2 chars.
-// I understand that this is a particular criteria, but this is the OpenSourceMagic, where you can change and adapt to your own specs.
-func ChkPersonName(name string, acceptEmpty bool) ChkPersonNameResult {
+// PersonNameRules parametrizes ChkPersonNameWith
+type PersonNameRules struct {
+	// MinWords is the minimum count of words
+	MinWords int
+	// MinWordLengths requires a distinct word for each given minimum length, in runes. I.E: {3, 2} requires a word with 3 runes or more, and another with 2 or more
+	MinWordLengths []int
+	// AllowedPunctuation lists the runes accepted besides letters and spaces. I.E: "'’-." accepts typographic apostrophes and abbreviations, like "J. R."
+	AllowedPunctuation string
+	// MaxRepeatedLetters is the maximum count of a letter repeated in a row, case-insensitive. 0 means no limit
+	MaxRepeatedLetters int
+	// ForbiddenWords are rejected, case and accent insensitive. Entries with spaces are matched as whole word sequences
+	ForbiddenWords []string
+	// AllowSingleName accepts single word names, from cultures without surnames, as long as the word meets the longest MinWordLengths
+	AllowSingleName bool
+	// AllowEmpty accepts empty or blank names
+	AllowEmpty bool
+}
+
+// DefaultPersonNameRules are the rules applied by ChkPersonName: at least two words, one with 3 runes or more and other with 2 or more,
+// made of letters, spaces, apostrophes and hyphens
+var DefaultPersonNameRules = PersonNameRules{
+	MinWords:           2,
+	MinWordLengths:     []int{3, 2},
+	AllowedPunctuation: "'-",
+}
+
+// chkWordLengths tells if there's a distinct word for each required minimum length
+func chkWordLengths(words []string, minLengths []int) bool {
+	required := append([]int(nil), minLengths...)
+	sort.Sort(sort.Reverse(sort.IntSlice(required)))
+
+	met := make([]bool, len(required))
+
+	for _, w := range words {
+		n := utf8.RuneCountInString(w)
+
+		for i, min := range required {
+			if !met[i] && n >= min {
+				met[i] = true
+				break
+			}
+		}
+	}
+
+	for _, m := range met {
+		if !m {
+			return false
+		}
+	}
+
+	return true
+}
+
+// chkRepeatedLetters tells if any letter is repeated in a row more than max times
+func chkRepeatedLetters(name string, max int) bool {
+	var (
+		last  rune
+		count int
+	)
+
+	for _, r := range name {
+		r = unicode.ToLower(r)
+
+		if r == last && unicode.IsLetter(r) {
+			if count++; count > max {
+				return true
+			}
+
+			continue
+		}
+
+		last, count = r, 1
+	}
+
+	return false
+}
+
+// chkForbiddenWords tells if the words have any forbidden word, or sequence of words
+func chkForbiddenWords(words []string, forbidden []string) bool {
+	normalize := func(s string) string {
+		return strings.Join(strings.Fields(strings.ToLower(RemoveAccents(s))), " ")
+	}
+
+	joined := " " + normalize(strings.Join(words, " ")) + " "
+
+	for _, f := range forbidden {
+		if f = normalize(f); f != "" && strings.Contains(joined, " "+f+" ") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ChkPersonNameWith validates a person name according the given rules, returning every violation found, or nil if the name is valid
+// Violations are reported in a fixed order: ChkPersonNameTooShort (for empty names), ChkPersonNamePolluted, ChkPersonNameTooFewWords,
+// ChkPersonNameTooSimple, ChkPersonNameRepeatedLetters and ChkPersonNameForbiddenWord.
+// Example: ChkPersonNameWith("Xiii Zzzzz", DefaultPersonNameRules) returns nil, but with MaxRepeatedLetters: 2 it returns []ChkPersonNameResult{ChkPersonNameRepeatedLetters}
+func ChkPersonNameWith(name string, rules PersonNameRules) []ChkPersonNameResult {
 	name = strings.TrimSpace(name)
 
-	// If name is empty, AND it's accepted, return ok. Else, cry!
 	if name == "" {
-		if !acceptEmpty {
-			return ChkPersonNameTooShort
+		if rules.AllowEmpty {
+			return nil
 		}
 
-		return ChkPersonNameOK
+		return []ChkPersonNameResult{ChkPersonNameTooShort}
 	}
 
-	// Person names doesn't accept other than letters, spaces and single quotes
+	var violations []ChkPersonNameResult
+
 	for _, r := range name {
-		if !unicode.IsLetter(r) && r != ' ' && r != '\'' && r != '-' {
-			return ChkPersonNamePolluted
+		if !unicode.IsLetter(r) && r != ' ' && !strings.ContainsRune(rules.AllowedPunctuation, r) {
+			violations = append(violations, ChkPersonNamePolluted)
+			break
 		}
 	}
 
-	// A complete name has to be at least 2 words.
-	a := strings.Fields(name)
+	words := strings.Fields(name)
 
-	if len(a) < 2 {
-		return ChkPersonNameTooFewWords
-	}
+	if rules.AllowSingleName && len(words) == 1 {
+		longest := 0
 
-	// At least two words, one with 3 chars and other with 2
-	found2 := false
-	found3 := false
+		for _, min := range rules.MinWordLengths {
+			if min > longest {
+				longest = min
+			}
+		}
 
-	for _, s := range a {
-		if !found3 && utf8.RuneCountInString(s) >= 3 {
-			found3 = true
-			continue
+		if utf8.RuneCountInString(words[0]) < longest {
+			violations = append(violations, ChkPersonNameTooSimple)
+		}
+	} else {
+		if len(words) < rules.MinWords {
+			violations = append(violations, ChkPersonNameTooFewWords)
 		}
 
-		if !found2 && utf8.RuneCountInString(s) >= 2 {
-			found2 = true
-			continue
+		if !chkWordLengths(words, rules.MinWordLengths) {
+			violations = append(violations, ChkPersonNameTooSimple)
 		}
 	}
 
-	if !found2 || !found3 {
-		return ChkPersonNameTooSimple
+	if rules.MaxRepeatedLetters > 0 && chkRepeatedLetters(name, rules.MaxRepeatedLetters) {
+		violations = append(violations, ChkPersonNameRepeatedLetters)
+	}
+
+	if len(rules.ForbiddenWords) > 0 && chkForbiddenWords(words, rules.ForbiddenWords) {
+		violations = append(violations, ChkPersonNameForbiddenWord)
+	}
+
+	return violations
+}
+
+// ChkPersonName validates a person name according DefaultPersonNameRules, returning ChkPersonNameOK or the first violation found
+// acceptEmpty tells if an empty name is valid. Use ChkPersonNameWith for other criteria, or to get all violations.
+// Example: ChkPersonName("Ana Lu", false) returns ChkPersonNameOK, and ChkPersonName("Ana", false) returns ChkPersonNameTooFewWords
+func ChkPersonName(name string, acceptEmpty bool) ChkPersonNameResult {
+	rules := DefaultPersonNameRules
+	rules.AllowEmpty = acceptEmpty
+
+	if violations := ChkPersonNameWith(name, rules); len(violations) > 0 {
+		return violations[0]
 	}
 
 	return ChkPersonNameOK
@@ -128,6 +249,7 @@ func NameFirst(name string, transformFlags TransformFlag) string {
 }
 
 // Initials returns the first and last words/names from the given input.
+// For person names, where particles and suffixes matter, see ParseName and PersonName.Initials
 func Initials(sequence string) string {
 	if len(sequence) < 2 {
 		return sequence
@@ -1,6 +1,9 @@
 package stringo
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestCheckPersonName(t *testing.T) {
 	type TestStructForCheckPersonName struct {
@@ -163,3 +166,41 @@ func TestNameInitials(t *testing.T) {
 		})
 	}
 }
+
+func TestChkPersonNameWith(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		name           string
+		rules          PersonNameRules
+		expectedOutput []ChkPersonNameResult
+	}{
+		{"default ok", "Friedrich Nietzsche", DefaultPersonNameRules, nil},
+		{"default empty", " ", DefaultPersonNameRules, []ChkPersonNameResult{ChkPersonNameTooShort}},
+		{"empty allowed", "", PersonNameRules{AllowEmpty: true}, nil},
+		{"all violations", "X1", DefaultPersonNameRules, []ChkPersonNameResult{ChkPersonNamePolluted, ChkPersonNameTooFewWords, ChkPersonNameTooSimple}},
+		{"period not allowed by default", "J. R. Tolkien", DefaultPersonNameRules, []ChkPersonNameResult{ChkPersonNamePolluted}},
+		{"period allowed", "J. R. Tolkien", PersonNameRules{MinWords: 2, AllowedPunctuation: "'’-."}, nil},
+		{"typographic apostrophe", "Shaquille O’Neal", PersonNameRules{AllowedPunctuation: "'’"}, nil},
+		{"three words", "Ana Souza", PersonNameRules{MinWords: 3}, []ChkPersonNameResult{ChkPersonNameTooFewWords}},
+		{"word lengths", "Al Bo", PersonNameRules{MinWordLengths: []int{2, 3}}, []ChkPersonNameResult{ChkPersonNameTooSimple}},
+		{"word lengths in any order", "Bo Ali", PersonNameRules{MinWordLengths: []int{2, 3}}, nil},
+		{"single name", "Suharto", PersonNameRules{MinWords: 2, MinWordLengths: []int{3, 2}, AllowSingleName: true}, nil},
+		{"single name too short", "Su", PersonNameRules{MinWords: 2, MinWordLengths: []int{3, 2}, AllowSingleName: true}, []ChkPersonNameResult{ChkPersonNameTooSimple}},
+		{"repeated letters", "Joããão Silva", PersonNameRules{MaxRepeatedLetters: 2}, []ChkPersonNameResult{ChkPersonNameRepeatedLetters}},
+		{"repeated letters case-insensitive", "AAa Silva", PersonNameRules{MaxRepeatedLetters: 2}, []ChkPersonNameResult{ChkPersonNameRepeatedLetters}},
+		{"repeated letters limit", "Aaron Buss", PersonNameRules{MaxRepeatedLetters: 2}, nil},
+		{"forbidden word", "Mister Asdf", PersonNameRules{ForbiddenWords: []string{"asdf"}}, []ChkPersonNameResult{ChkPersonNameForbiddenWord}},
+		{"forbidden word accents", "Fulano de Tál", PersonNameRules{ForbiddenWords: []string{"fulano de tal"}}, []ChkPersonNameResult{ChkPersonNameForbiddenWord}},
+		{"forbidden word is whole", "Asdfgh Silva", PersonNameRules{ForbiddenWords: []string{"asdf"}}, nil},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := ChkPersonNameWith(tc.name, tc.rules)
+
+			if !reflect.DeepEqual(r, tc.expectedOutput) {
+				t.Errorf("Test has failed!\n\tName: %s\n\tExpected: %v, \n\tGot: %v,", tc.name, tc.expectedOutput, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package stringo
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// nameVariantGroups are the built-in groups of equivalent names, like a name and its nicknames and diminutives, by language
+// The first name of each group is the formal one. Groups carry no gender, and a name may belong to many groups.
+var nameVariantGroups = map[string][][]string{
+	"en": {
+		{"William", "Bill", "Billy", "Will", "Willy", "Liam"},
+		{"Robert", "Bob", "Bobby", "Rob", "Robbie", "Bert"},
+		{"Richard", "Rick", "Ricky", "Rich", "Dick"},
+		{"Edward", "Ed", "Eddie", "Ted", "Teddy", "Ned"},
+		{"James", "Jim", "Jimmy", "Jamie"},
+		{"John", "Johnny", "Jack", "Jon"},
+		{"Michael", "Mike", "Mikey", "Mick"},
+		{"Thomas", "Tom", "Tommy"},
+		{"Joseph", "Joe", "Joey"},
+		{"Charles", "Charlie", "Chuck", "Chas"},
+		{"Christopher", "Chris", "Kit"},
+		{"Daniel", "Dan", "Danny"},
+		{"David", "Dave", "Davey"},
+		{"Anthony", "Tony"},
+		{"Andrew", "Andy", "Drew"},
+		{"Matthew", "Matt"},
+		{"Nicholas", "Nick", "Nicky"},
+		{"Benjamin", "Ben", "Benny"},
+		{"Samuel", "Sam", "Sammy"},
+		{"Alexander", "Alex", "Al", "Sandy"},
+		{"Alexandra", "Alex", "Lexi", "Sandra", "Sandy"},
+		{"Elizabeth", "Liz", "Lizzie", "Beth", "Betty", "Eliza", "Libby"},
+		{"Margaret", "Maggie", "Meg", "Peggy", "Marge"},
+		{"Katherine", "Kate", "Katie", "Kathy", "Kat", "Catherine"},
+		{"Jennifer", "Jen", "Jenny"},
+		{"Patricia", "Pat", "Patty", "Trish"},
+		{"Patrick", "Pat", "Paddy"},
+		{"Rebecca", "Becky", "Becca"},
+		{"Susan", "Sue", "Susie"},
+		{"Deborah", "Deb", "Debbie"},
+		{"Victoria", "Vicky", "Tori"},
+		{"Samantha", "Sam", "Sammy"},
+		{"Frederick", "Fred", "Freddie"},
+		{"Henry", "Harry", "Hank"},
+		{"Lawrence", "Larry"},
+		{"Stephen", "Steve", "Steven"},
+		{"Timothy", "Tim", "Timmy"},
+		{"Gregory", "Greg"},
+		{"Jacob", "Jake"},
+		{"Zachary", "Zach", "Zack"},
+	},
+	"pt": {
+		{"José", "Zé", "Zezinho", "Juca", "Zeca"},
+		{"Francisco", "Chico", "Chiquinho", "Xico", "Kiko"},
+		{"Antônio", "Antonio", "Tonho", "Toninho", "Tonico", "Tõe"},
+		{"Sebastião", "Tião", "Bastião"},
+		{"Luiz", "Luís", "Luis", "Lula"},
+		{"Thiago", "Tiago"},
+		{"João", "Joãozinho", "Jão"},
+		{"Eduardo", "Edu", "Dudu"},
+		{"Fernando", "Nando", "Fernandinho"},
+		{"Roberto", "Beto", "Betinho"},
+		{"Alberto", "Beto"},
+		{"Gilberto", "Gil", "Beto"},
+		{"Ricardo", "Rick", "Cacá"},
+		{"Carlos", "Carlinhos", "Cacá"},
+		{"Rafael", "Rafa"},
+		{"Gustavo", "Guga", "Gus"},
+		{"Guilherme", "Gui"},
+		{"Rodrigo", "Digo", "Rodriguinho"},
+		{"Manuel", "Manoel", "Mané", "Manéco"},
+		{"Raimundo", "Mundinho", "Mundico"},
+		{"Maria", "Mariazinha", "Mari"},
+		{"Ana", "Aninha", "Anita"},
+		{"Fernanda", "Nanda", "Fê"},
+		{"Gabriela", "Gabi"},
+		{"Isabel", "Bel", "Belinha"},
+		{"Isabela", "Bela", "Bel"},
+		{"Conceição", "Ceiça", "Ceição"},
+		{"Aparecida", "Cida"},
+		{"Teresa", "Tereza", "Terezinha", "Tetê"},
+		{"Rosângela", "Rô"},
+		{"Rosa", "Rosinha"},
+	},
+	"es": {
+		{"José", "Pepe", "Pepito", "Chepe", "Chema"},
+		{"Francisco", "Paco", "Pancho", "Curro", "Quico", "Frasco"},
+		{"Ignacio", "Nacho"},
+		{"Guillermo", "Memo", "Guille"},
+		{"Enrique", "Quique", "Kike"},
+		{"Jesús", "Chucho", "Chuy", "Suso"},
+		{"Manuel", "Manolo", "Manu", "Lolo"},
+		{"Alejandro", "Álex", "Alex", "Jandro"},
+		{"Eduardo", "Lalo", "Edu"},
+		{"Antonio", "Toño", "Toni", "Antoñito"},
+		{"Rafael", "Rafa", "Rafi"},
+		{"Roberto", "Beto", "Tito"},
+		{"Luis", "Lucho", "Luisito"},
+		{"Gonzalo", "Chalo", "Gonza"},
+		{"Rosario", "Charo", "Chayo"},
+		{"Dolores", "Lola", "Loli"},
+		{"Concepción", "Concha", "Conchita", "Chelo"},
+		{"Mercedes", "Merche", "Meche"},
+		{"Guadalupe", "Lupe", "Lupita"},
+		{"Consuelo", "Chelo"},
+		{"Isabel", "Chabela", "Isa"},
+		{"Teresa", "Tere", "Teresita"},
+	},
+	"fr": {
+		{"Jean", "Jeannot"},
+		{"François", "Fanfan", "Franck"},
+		{"Guillaume", "Guigui"},
+		{"Nicolas", "Nico"},
+		{"Frédéric", "Fred"},
+		{"Dominique", "Dom"},
+		{"Marguerite", "Margot", "Maguy"},
+		{"Catherine", "Cathy", "Cat"},
+		{"Isabelle", "Isa", "Zaza"},
+	},
+	"de": {
+		{"Johannes", "Hans", "Jo", "Hannes"},
+		{"Wilhelm", "Willi", "Wim"},
+		{"Friedrich", "Fritz"},
+		{"Joseph", "Sepp", "Seppl", "Josef"},
+		{"Margarethe", "Grete", "Gretchen", "Gretel"},
+		{"Elisabeth", "Lisa", "Liesel", "Else"},
+		{"Katharina", "Käthe", "Kathi"},
+		{"Wolfgang", "Wolf"},
+	},
+	"it": {
+		{"Giuseppe", "Peppe", "Beppe", "Pino"},
+		{"Giovanni", "Gianni", "Nino", "Vanni"},
+		{"Francesco", "Franco", "Cecco", "Checco"},
+		{"Salvatore", "Totò", "Turi"},
+		{"Antonio", "Tonino", "Totò", "Nino"},
+		{"Alessandro", "Sandro", "Alex"},
+		{"Roberto", "Berto", "Bobo"},
+		{"Maria", "Mariella", "Mimì"},
+	},
+}
+
+// nameVariantRef locates a group of equivalent names
+type nameVariantRef struct {
+	lang  string
+	group int
+}
+
+// nameVariantRegistry indexes groups of equivalent names by normalized name
+type nameVariantRegistry struct {
+	mutex  sync.RWMutex
+	groups map[string][][]string
+	index  map[string][]nameVariantRef
+}
+
+// nameVariants is the registry used by the package level functions, loaded with nameVariantGroups
+var nameVariants = newNameVariantRegistry()
+
+// newNameVariantRegistry returns a registry with the built-in groups
+func newNameVariantRegistry() *nameVariantRegistry {
+	r := &nameVariantRegistry{groups: map[string][][]string{}, index: map[string][]nameVariantRef{}}
+
+	// languages are sorted, so names shared across languages have their variants in a stable order
+	langs := make([]string, 0, len(nameVariantGroups))
+
+	for lang := range nameVariantGroups {
+		langs = append(langs, lang)
+	}
+
+	sort.Strings(langs)
+
+	for _, lang := range langs {
+		for _, g := range nameVariantGroups[lang] {
+			r.add(lang, g)
+		}
+	}
+
+	return r
+}
+
+// nameVariantKey normalizes a name for lookup: lowercase and without accents
+func nameVariantKey(name string) string {
+	return strings.ToLower(RemoveAccents(strings.TrimSpace(name)))
+}
+
+// add appends a group to the registry. The caller must hold the write lock, if the registry is shared.
+func (r *nameVariantRegistry) add(lang string, names []string) {
+	ref := nameVariantRef{lang: lang, group: len(r.groups[lang])}
+	r.groups[lang] = append(r.groups[lang], append([]string(nil), names...))
+
+	seen := map[string]bool{}
+
+	for _, n := range names {
+		if key := nameVariantKey(n); !seen[key] {
+			seen[key] = true
+			r.index[key] = append(r.index[key], ref)
+		}
+	}
+}
+
+// lookup returns the groups having the given name, from the given languages only, unless langs is empty
+func (r *nameVariantRegistry) lookup(name string, langs ...string) [][]string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var groups [][]string
+
+	for _, ref := range r.index[nameVariantKey(name)] {
+		if len(langs) > 0 && !containsString(langs, ref.lang) {
+			continue
+		}
+
+		groups = append(groups, r.groups[ref.lang][ref.group])
+	}
+
+	return groups
+}
+
+// containsString tells if the slice has the given string
+func containsString(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RegisterNameVariants adds a group of equivalent names, for the given language, to the nickname table
+// The first name should be the formal one. Groups sharing names are not merged, but lookups go through all of them.
+// Example: RegisterNameVariants(language.Portuguese, "Wellington", "Well", "Tom")
+func RegisterNameVariants(lang language.Tag, names ...string) {
+	if len(names) < 2 {
+		return
+	}
+
+	base, _ := lang.Base()
+
+	nameVariants.mutex.Lock()
+	nameVariants.add(base.String(), names)
+	nameVariants.mutex.Unlock()
+}
+
+// NameVariants returns the names equivalent to the given one, like its formal form, nicknames and diminutives, in any language
+// Lookups are case and accent insensitive. The given name itself is left out, as well as spelling variants differing only by accents.
+// Formal names come first, then the others, in table order, by language. If langs is given, only groups from those languages are considered.
+// Example: NameVariants("Bill") returns []string{"William", "Billy", "Will", "Willy", "Liam"}
+// Example: NameVariants("Zé") returns []string{"José", "Zezinho", "Juca", "Zeca"}
+func NameVariants(name string, langs ...language.Tag) []string {
+	bases := make([]string, 0, len(langs))
+
+	for _, l := range langs {
+		base, _ := l.Base()
+		bases = append(bases, base.String())
+	}
+
+	groups := nameVariants.lookup(name, bases...)
+	seen := map[string]bool{nameVariantKey(name): true}
+
+	var formal, others []string
+
+	for _, g := range groups {
+		for i, n := range g {
+			if key := nameVariantKey(n); !seen[key] {
+				seen[key] = true
+
+				if i == 0 {
+					formal = append(formal, n)
+				} else {
+					others = append(others, n)
+				}
+			}
+		}
+	}
+
+	return append(formal, others...)
+}
+
+// NameLocales returns the languages in which the given name is known, according the nickname table, sorted by tag
+// It's a heuristic for guessing the origin of a contact, not a reliable source.
+// Example: NameLocales("Paco") returns []language.Tag{language.Spanish}
+func NameLocales(name string) []language.Tag {
+	nameVariants.mutex.RLock()
+
+	seen := map[string]bool{}
+
+	for _, ref := range nameVariants.index[nameVariantKey(name)] {
+		seen[ref.lang] = true
+	}
+
+	nameVariants.mutex.RUnlock()
+
+	langs := make([]string, 0, len(seen))
+
+	for l := range seen {
+		langs = append(langs, l)
+	}
+
+	sort.Strings(langs)
+
+	tags := make([]language.Tag, 0, len(langs))
+
+	for _, l := range langs {
+		tags = append(tags, language.Make(l))
+	}
+
+	return tags
+}
+
+// NamesEquivalent tells if two person names may refer to the same person, taking nicknames into account for the first name
+// Other words must be equal, case and accent insensitive, and both names must have the same count of words.
+// Example: NamesEquivalent("Bill Gates", "William Gates") returns true
+func NamesEquivalent(a, b string) bool {
+	wa, wb := strings.Fields(a), strings.Fields(b)
+
+	if len(wa) == 0 || len(wa) != len(wb) {
+		return false
+	}
+
+	for i := 1; i < len(wa); i++ {
+		if nameVariantKey(wa[i]) != nameVariantKey(wb[i]) {
+			return false
+		}
+	}
+
+	first := nameVariantKey(wb[0])
+
+	if nameVariantKey(wa[0]) == first {
+		return true
+	}
+
+	for _, v := range NameVariants(wa[0]) {
+		if nameVariantKey(v) == first {
+			return true
+		}
+	}
+
+	return false
+}
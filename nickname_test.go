@@ -0,0 +1,126 @@
+package stringo
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestNameVariants(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		langs          []language.Tag
+		expectedOutput []string
+	}{
+		{"empty", "", nil, nil},
+		{"unknown", "Xyzzy", nil, nil},
+		{"nickname", "Bill", nil, []string{"William", "Billy", "Will", "Willy", "Liam"}},
+		{"formal", "Francisco", []language.Tag{language.Portuguese}, []string{"Chico", "Chiquinho", "Xico", "Kiko"}},
+		{"diminutive pt", "Zé", nil, []string{"José", "Zezinho", "Juca", "Zeca"}},
+		{"case and accents", "ze", nil, []string{"José", "Zezinho", "Juca", "Zeca"}},
+		{"diminutive es", "Paco", nil, []string{"Francisco", "Pancho", "Curro", "Quico", "Frasco"}},
+		{"from diminutive", "Chico", nil, []string{"Francisco", "Chiquinho", "Xico", "Kiko"}},
+		{"filtered by language", "Pat", []language.Tag{language.English}, []string{"Patricia", "Patrick", "Patty", "Trish", "Paddy"}},
+		{"other language", "Bill", []language.Tag{language.Spanish}, nil},
+		{"formal spelling variant", "Terezinha", []language.Tag{language.Portuguese}, []string{"Teresa", "Tereza", "Tetê"}},
+		{"formal name apart from similar ones", "Rosa", []language.Tag{language.Portuguese}, []string{"Rosinha"}},
+		{"separate formal names", "Isabela", []language.Tag{language.Portuguese}, []string{"Bela", "Bel"}},
+		{"nickname of separate formal names", "Bel", []language.Tag{language.Portuguese}, []string{"Isabel", "Isabela", "Belinha", "Bela"}},
+		{"shared across languages", "Alex", nil, []string{"Alexander", "Alexandra", "Alejandro", "Alessandro", "Al", "Sandy", "Lexi", "Sandra", "Jandro", "Sandro"}},
+		{"shared formal name", "Maria", nil, []string{"Mariella", "Mimì", "Mariazinha", "Mari"}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := NameVariants(tc.input, tc.langs...)
+
+			if !reflect.DeepEqual(r, tc.expectedOutput) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestNameVariantsStableOrder(t *testing.T) {
+	// a fresh registry is built from the map of languages each time, which Go iterates in random order
+	expected := newNameVariantRegistry().lookup("Alex")
+
+	for i := 0; i < 20; i++ {
+		if r := newNameVariantRegistry().lookup("Alex"); !reflect.DeepEqual(r, expected) {
+			t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", "Alex", expected, r)
+		}
+	}
+}
+
+func TestRegisterNameVariants(t *testing.T) {
+	// registers into a fresh registry, so other tests don't see the new names
+	saved := nameVariants
+	nameVariants = newNameVariantRegistry()
+
+	t.Cleanup(func() {
+		nameVariants = saved
+	})
+
+	RegisterNameVariants(language.BrazilianPortuguese, "Wellington", "Well", "Tom")
+
+	if r := NameVariants("Well"); !reflect.DeepEqual(r, []string{"Wellington", "Tom"}) {
+		t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", "Well", []string{"Wellington", "Tom"}, r)
+	}
+
+	if r := NameLocales("Wellington"); !reflect.DeepEqual(r, []language.Tag{language.Portuguese}) {
+		t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v", "Wellington", []language.Tag{language.Portuguese}, r)
+	}
+}
+
+func TestNameLocales(t *testing.T) {
+	tcs := []struct {
+		input          string
+		expectedOutput []language.Tag
+	}{
+		{"", []language.Tag{}},
+		{"Bill", []language.Tag{language.English}},
+		{"Paco", []language.Tag{language.Spanish}},
+		{"Jose", []language.Tag{language.Spanish, language.Portuguese}},
+		{"Antonio", []language.Tag{language.Spanish, language.Italian, language.Portuguese}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			r := NameLocales(tc.input)
+
+			if !reflect.DeepEqual(r, tc.expectedOutput) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestNamesEquivalent(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		a, b           string
+		expectedOutput bool
+	}{
+		{"empty", "", "", false},
+		{"same", "Bill Gates", "bill gates", true},
+		{"nickname", "Bill Gates", "William Gates", true},
+		{"formal first", "William Gates", "Bill Gates", true},
+		{"accents", "Zé Silva", "Jose da Silva", false},
+		{"diminutive", "Zé da Silva", "José da Silva", true},
+		{"surname differs", "Bill Gates", "William Smith", false},
+		{"unrelated first names", "Bill Gates", "Robert Gates", false},
+		{"word count", "Bill Gates", "William Henry Gates", false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := NamesEquivalent(tc.a, tc.b)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q, %q,\n\tExpected: %v, \n\tGot: %v", tc.a, tc.b, tc.expectedOutput, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package stringo
+
+import (
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+type NormalizationForm uint8
+
+const (
+	// NormalizeNFC Canonical decomposition, followed by canonical composition. It's the form most texts are already in.
+	NormalizeNFC NormalizationForm = 0
+	// NormalizeNFD Canonical decomposition. I.E: "é" becomes "e" followed by a combining acute accent
+	NormalizeNFD NormalizationForm = 1
+	// NormalizeNFKC Compatibility decomposition, followed by canonical composition. I.E: "ﬁ" becomes "fi" and "①" becomes "1"
+	NormalizeNFKC NormalizationForm = 2
+	// NormalizeNFKD Compatibility decomposition
+	NormalizeNFKD NormalizationForm = 3
+)
+
+// defaultIgnorables are invisible runes that NFKC_Casefold discards, like soft hyphens, zero width spaces and variation selectors
+var defaultIgnorables = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00ad, Hi: 0x00ad, Stride: 1},
+		{Lo: 0x034f, Hi: 0x034f, Stride: 1},
+		{Lo: 0x061c, Hi: 0x061c, Stride: 1},
+		{Lo: 0x115f, Hi: 0x1160, Stride: 1},
+		{Lo: 0x17b4, Hi: 0x17b5, Stride: 1},
+		{Lo: 0x180b, Hi: 0x180f, Stride: 1},
+		{Lo: 0x200b, Hi: 0x200f, Stride: 1},
+		{Lo: 0x202a, Hi: 0x202e, Stride: 1},
+		{Lo: 0x2060, Hi: 0x206f, Stride: 1},
+		{Lo: 0x3164, Hi: 0x3164, Stride: 1},
+		{Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
+		{Lo: 0xfeff, Hi: 0xfeff, Stride: 1},
+		{Lo: 0xffa0, Hi: 0xffa0, Stride: 1},
+		{Lo: 0xfff0, Hi: 0xfff8, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1bca0, Hi: 0x1bca3, Stride: 1},
+		{Lo: 0x1d173, Hi: 0x1d17a, Stride: 1},
+		{Lo: 0xe0000, Hi: 0xe0fff, Stride: 1},
+	},
+}
+
+// Normalize returns the given string in the requested Unicode normalization form
+// See https://unicode.org/reports/tr15/ for details about normalization forms
+// Example: Normalize("ﬁancé", NormalizeNFKC) returns "fiancé"
+func Normalize(s string, form NormalizationForm) string {
+	if s == "" {
+		return s
+	}
+
+	switch form {
+	case NormalizeNFD:
+		return norm.NFD.String(s)
+	case NormalizeNFKC:
+		return norm.NFKC.String(s)
+	case NormalizeNFKD:
+		return norm.NFKD.String(s)
+	}
+
+	return norm.NFC.String(s)
+}
+
+// CaseFold applies NFKC case folding (NFKC_Casefold), making strings comparable regardless of case, width and compatibility variants
+// It's the recommended way to compare identifiers like usernames. Observe that the result is meant for comparison, not for display.
+// Example: CaseFold("Straße") and CaseFold("STRASSE") both return "strasse"
+// Example: CaseFold("ＡＢＣ") returns "abc"
+func CaseFold(s string) string {
+	if s == "" {
+		return s
+	}
+
+	t := transform.Chain(norm.NFKC, cases.Fold(), runes.Remove(runes.In(defaultIgnorables)), norm.NFKC)
+
+	result, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+
+	return result
+}
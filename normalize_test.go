@@ -0,0 +1,104 @@
+package stringo
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		form           NormalizationForm
+		expectedOutput string
+	}{
+		{"empty", "", NormalizeNFC, ""},
+		{"nfc composes", "é", NormalizeNFC, "é"},
+		{"nfd decomposes", "é", NormalizeNFD, "é"},
+		{"nfkc ligature", "ﬁancé", NormalizeNFKC, "fiancé"},
+		{"nfkc fullwidth", "ＡＢＣ１", NormalizeNFKC, "ABC1"},
+		{"nfkd decomposes compatibility and canonical", "ﬁé", NormalizeNFKD, "fié"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Normalize(tc.input, tc.form)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestCaseFold(t *testing.T) {
+	tcs := []defaultTestStruct{
+		{"empty", "", ""},
+		{"german", "Straße", "strasse"},
+		{"fullwidth", "ＡＢＣ", "abc"},
+		{"zero width space", "user​name", "username"},
+		{"greek final sigma", "ΟΔΟΣ", "οδοσ"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := CaseFold(tc.input.(string))
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+
+	if tr := Transform(" STRASSE ", 0, TransformTrim|TransformCaseFold); tr != CaseFold("Straße") {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s", CaseFold("Straße"), tr)
+	}
+}
+
+func TestIsConfusable(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		a, b           string
+		expectedOutput bool
+	}{
+		{"identical", "paypal", "paypal", true},
+		{"cyrillic a", "pаypal", "paypal", true},
+		{"greek omicron", "gοοgle", "google", true},
+		{"digit zero and letter O", "G00GLE", "GOOGLE", true},
+		{"rn and m", "rnicrosoft", "microsoft", true},
+		{"fullwidth", "ｐａｙｐａｌ", "paypal", true},
+		{"different words", "paypal", "paypai", false},
+		{"case matters", "PAYPAL", "paypal", false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := IsConfusable(tc.a, tc.b)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q and %q,\n\tExpected: %t, \n\tGot: %t", tc.a, tc.b, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestHasMixedScripts(t *testing.T) {
+	tcs := []defaultTestStruct{
+		{"empty", "", false},
+		{"latin", "paypal", false},
+		{"latin with accents and digits", "Ação 2026!", false},
+		{"cyrillic and latin", "pаypal", true},
+		{"greek and latin", "gοogle", true},
+		{"korean with han", "韓國 한국", false},
+		{"han and cyrillic", "東京 Москва", true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := HasMixedScripts(tc.input.(string))
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %t", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
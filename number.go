@@ -0,0 +1,389 @@
+package stringo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// numberSymbols are the number formatting conventions of a language
+type numberSymbols struct {
+	decimal string
+	group   string
+	percent string
+	indian  bool
+}
+
+// numberLocales are the number symbols by language base, according CLDR
+var numberLocales = map[string]numberSymbols{
+	"en": {".", ",", "%", false},
+	"pt": {",", ".", "%", false},
+	"es": {",", ".", "\u00a0%", false},
+	"it": {",", ".", "%", false},
+	"de": {",", ".", "\u00a0%", false},
+	"fr": {",", "\u202f", "\u202f%", false},
+	"ru": {",", "\u00a0", "\u00a0%", false},
+	"hi": {".", ",", "%", true},
+	"ja": {".", ",", "%", false},
+	"zh": {".", ",", "%", false},
+}
+
+// numberSpaces are the spaces accepted as group separators by ParseNumber, in any language
+var numberSpaces = []string{" ", "\u00a0", "\u202f", "\u2009"}
+
+// numberSymbolsFor returns the number symbols for the language, falling back to English
+// English in India uses the Indian grouping, like Hindi.
+func numberSymbolsFor(lang language.Tag) numberSymbols {
+	base, _ := lang.Base()
+
+	symbols, ok := numberLocales[base.String()]
+	if !ok {
+		symbols = numberLocales["en"]
+	}
+
+	if region, confidence := lang.Region(); confidence == language.Exact && region.String() == "IN" {
+		symbols.indian = true
+	}
+
+	return symbols
+}
+
+// validNumberGroups tells if the groups of digits, split by group separators, are in standard or Indian positions
+// Standard grouping has groups of 3 digits, Indian has 3 digits for the last group and 2 for the others. The first group may be shorter.
+func validNumberGroups(groups []string, indian bool) bool {
+	for i, g := range groups {
+		size := 3
+
+		if indian && i < len(groups)-1 {
+			size = 2
+		}
+
+		if g == "" || len(g) > size || i > 0 && len(g) != size || strings.Trim(g, "0123456789") != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseNumber converts a localized number to float64, like "1.234,5" in Portuguese or "12,34,567.8" in Hindi
+// Group separators must be in valid positions: "1,2,3" isn't a number in English. Spaces, including no-break and thin spaces,
+// are accepted as group separators in any language. Surrounding whitespace, a leading sign and an exponent, as in "1.5E3", are accepted.
+// It fails with ErrNumberSyntax, or ErrNumberRange if the number overflows.
+// Example: ParseNumber("1.234.567,89", language.BrazilianPortuguese) returns 1234567.89
+func ParseNumber(s string, lang language.Tag) (float64, error) {
+	symbols := numberSymbolsFor(lang)
+	n := strings.TrimSpace(s)
+	sign := ""
+
+	if n != "" && (n[0] == '+' || n[0] == '-') {
+		sign, n = n[:1], n[1:]
+	}
+
+	exponent := ""
+
+	if i := strings.IndexAny(n, "eE"); i >= 0 {
+		n, exponent = n[:i], n[i:]
+
+		if _, err := strconv.Atoi(exponent[1:]); err != nil || exponent[1:] == "" {
+			return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+		}
+	}
+
+	integer, fraction := n, ""
+
+	if i := strings.Index(n, symbols.decimal); i >= 0 {
+		integer, fraction = n[:i], n[i+len(symbols.decimal):]
+
+		if fraction == "" || strings.Trim(fraction, "0123456789") != "" {
+			return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+		}
+	}
+
+	// only one kind of group separator is allowed, the language's own or a space
+	separators := append([]string{symbols.group}, numberSpaces...)
+	groups := []string{integer}
+
+	for _, sep := range separators {
+		if strings.Contains(integer, sep) {
+			groups = strings.Split(integer, sep)
+			break
+		}
+	}
+
+	switch {
+	case integer == "" && fraction == "":
+		return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	case len(groups) == 1 && strings.Trim(integer, "0123456789") != "":
+		return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	case len(groups) > 1 && !validNumberGroups(groups, symbols.indian):
+		return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	}
+
+	normalized := sign + strings.Join(groups, "")
+
+	if fraction != "" {
+		normalized += "." + fraction
+	}
+
+	f, err := strconv.ParseFloat(normalized+exponent, 64)
+
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("%w: %q", ErrNumberRange, s)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrNumberSyntax, s)
+	}
+
+	return f, nil
+}
+
+// NumberRounding is how FormatNumber rounds numbers to their precision
+type NumberRounding uint8
+
+const (
+	// NumberRoundHalfEven rounds to the nearest, and ties to the even digit, as banks and ICU do
+	NumberRoundHalfEven NumberRounding = 0
+	// NumberRoundHalfUp rounds to the nearest, and ties away from zero, as taught at school
+	NumberRoundHalfUp NumberRounding = 1
+	// NumberRoundHalfDown rounds to the nearest, and ties toward zero
+	NumberRoundHalfDown NumberRounding = 2
+	// NumberRoundUp rounds away from zero
+	NumberRoundUp NumberRounding = 3
+	// NumberRoundDown rounds toward zero, truncating
+	NumberRoundDown NumberRounding = 4
+	// NumberRoundCeiling rounds toward positive infinity
+	NumberRoundCeiling NumberRounding = 5
+	// NumberRoundFloor rounds toward negative infinity
+	NumberRoundFloor NumberRounding = 6
+)
+
+// NumberGrouping is how FormatNumber groups integer digits
+type NumberGrouping uint8
+
+const (
+	// NumberGroupingLocale groups as the language does, in thousands or, for India, in lakhs and crores
+	NumberGroupingLocale NumberGrouping = 0
+	// NumberGroupingNone doesn't group digits
+	NumberGroupingNone NumberGrouping = 1
+	// NumberGroupingThousands groups digits by 3, as in 1,234,567
+	NumberGroupingThousands NumberGrouping = 2
+	// NumberGroupingIndian groups the last 3 digits, then by 2, as in 12,34,567
+	NumberGroupingIndian NumberGrouping = 3
+)
+
+// NumberFormatOptions parametrizes FormatNumber. The zero value formats integers, rounding half to even, grouped as the language does.
+type NumberFormatOptions struct {
+	// Precision is the count of fraction digits. Negative means as many as needed to represent the number exactly
+	Precision int
+	// Rounding is how the number is rounded to the precision
+	Rounding NumberRounding
+	// Grouping is how integer digits are grouped
+	Grouping NumberGrouping
+	// GroupSeparator replaces the language's group separator, I.E: "\u2009" for thin spaces
+	GroupSeparator string
+	// Percent multiplies the number by 100, moving its decimal point, and appends the language's percent sign
+	Percent bool
+	// Scientific formats the number as a mantissa, with Precision fraction digits, and an exponent, as in 1.23E4. Digits aren't grouped
+	Scientific bool
+}
+
+// roundDecimal rounds a non-negative decimal number, given as digits and a fraction, to the given fraction digits
+// It returns the rounded integer and fraction digits.
+func roundDecimal(integer, fraction string, precision int, rounding NumberRounding, negative bool) (string, string) {
+	if precision < 0 {
+		return integer, fraction
+	}
+
+	if len(fraction) <= precision {
+		return integer, fraction + strings.Repeat("0", precision-len(fraction))
+	}
+
+	kept, rest := integer+fraction[:precision], fraction[precision:]
+	nonZero := strings.Trim(rest, "0") != ""
+	tail := strings.Trim(rest[1:], "0") != ""
+	increment := false
+
+	switch rounding {
+	case NumberRoundHalfEven:
+		odd := (kept[len(kept)-1]-'0')%2 == 1
+		increment = rest[0] > '5' || rest[0] == '5' && (tail || odd)
+	case NumberRoundHalfUp:
+		increment = rest[0] >= '5'
+	case NumberRoundHalfDown:
+		increment = rest[0] > '5' || rest[0] == '5' && tail
+	case NumberRoundUp:
+		increment = nonZero
+	case NumberRoundCeiling:
+		increment = nonZero && !negative
+	case NumberRoundFloor:
+		increment = nonZero && negative
+	}
+
+	if increment {
+		digits := []byte(kept)
+		i := len(digits) - 1
+
+		for ; i >= 0 && digits[i] == '9'; i-- {
+			digits[i] = '0'
+		}
+
+		if i < 0 {
+			digits = append([]byte{'1'}, digits...)
+		} else {
+			digits[i]++
+		}
+
+		kept = string(digits)
+	}
+
+	return kept[:len(kept)-precision], kept[len(kept)-precision:]
+}
+
+// shiftDecimal moves the decimal point of a non-negative decimal number, given as digits and a fraction, n digits to the right
+func shiftDecimal(integer, fraction string, n int) (string, string) {
+	if n == 0 {
+		return integer, fraction
+	}
+
+	if len(fraction) < n {
+		fraction += strings.Repeat("0", n-len(fraction))
+	}
+
+	integer = strings.TrimLeft(integer+fraction[:n], "0")
+
+	if integer == "" {
+		integer = "0"
+	}
+
+	return integer, fraction[n:]
+}
+
+// groupDigits inserts the separator between groups of integer digits
+func groupDigits(integer, sep string, indian bool) string {
+	if len(integer) <= 3 {
+		return integer
+	}
+
+	groups := []string{integer[len(integer)-3:]}
+	integer = integer[:len(integer)-3]
+	size := 3
+
+	if indian {
+		size = 2
+	}
+
+	for len(integer) > size {
+		groups = append([]string{integer[len(integer)-size:]}, groups...)
+		integer = integer[:len(integer)-size]
+	}
+
+	return strings.Join(append([]string{integer}, groups...), sep)
+}
+
+// FormatNumber formats a number as the given language does, with the given precision, rounding, grouping and notation
+// Rounding is done on the shortest decimal representation of the number, so 1.005 rounds half up to 1.01.
+// Example: FormatNumber(1234567.891, language.BrazilianPortuguese, NumberFormatOptions{Precision: 2}) returns "1.234.567,89"
+// Example: FormatNumber(1234567, language.MustParse("en-IN"), NumberFormatOptions{}) returns "12,34,567"
+// Example: FormatNumber(0.256, language.English, NumberFormatOptions{Percent: true, Precision: 1}) returns "25.6%"
+func FormatNumber(f float64, lang language.Tag, opts NumberFormatOptions) string {
+	symbols := numberSymbolsFor(lang)
+
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "∞"
+	case math.IsInf(f, -1):
+		return "-∞"
+	}
+
+	// percentages move the decimal point of the decimal representation, since multiplying the float by 100 adds binary noise
+	suffix, shift := "", 0
+
+	if opts.Percent {
+		suffix, shift = symbols.percent, 2
+	}
+
+	negative := f < 0
+	abs := math.Abs(f)
+
+	if opts.Scientific {
+		mantissa, exponent := "0", 0
+
+		if abs != 0 {
+			e := strconv.FormatFloat(abs, 'e', -1, 64)
+			i := strings.IndexByte(e, 'e')
+			mantissa = e[:i]
+			exponent, _ = strconv.Atoi(e[i+1:])
+			exponent += shift
+		}
+
+		integer, fraction := mantissa, ""
+
+		if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+			integer, fraction = mantissa[:i], mantissa[i+1:]
+		}
+
+		integer, fraction = roundDecimal(integer, fraction, opts.Precision, opts.Rounding, negative)
+
+		// rounding 9.99 may carry to 10.00, whose fraction digits are all zeros
+		if integer == "10" {
+			integer, exponent = "1", exponent+1
+		}
+
+		s := integer
+
+		if fraction != "" {
+			s += symbols.decimal + fraction
+		}
+
+		if negative && strings.Trim(integer+fraction, "0") != "" {
+			s = "-" + s
+		}
+
+		return s + "E" + strconv.Itoa(exponent) + suffix
+	}
+
+	decimal := strconv.FormatFloat(abs, 'f', -1, 64)
+	integer, fraction := decimal, ""
+
+	if i := strings.IndexByte(decimal, '.'); i >= 0 {
+		integer, fraction = decimal[:i], decimal[i+1:]
+	}
+
+	integer, fraction = shiftDecimal(integer, fraction, shift)
+	integer, fraction = roundDecimal(integer, fraction, opts.Precision, opts.Rounding, negative)
+
+	sep := symbols.group
+
+	if opts.GroupSeparator != "" {
+		sep = opts.GroupSeparator
+	}
+
+	switch opts.Grouping {
+	case NumberGroupingLocale:
+		integer = groupDigits(integer, sep, symbols.indian)
+	case NumberGroupingThousands:
+		integer = groupDigits(integer, sep, false)
+	case NumberGroupingIndian:
+		integer = groupDigits(integer, sep, true)
+	}
+
+	s := integer
+
+	if fraction != "" {
+		s += symbols.decimal + fraction
+	}
+
+	if negative && strings.Trim(integer+fraction, "0"+sep) != "" {
+		s = "-" + s
+	}
+
+	return s + suffix
+}
@@ -0,0 +1,117 @@
+package stringo
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParseNumber(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		lang           language.Tag
+		expectedOutput float64
+		expectedError  error
+	}{
+		{"english", "1,234,567.89", language.English, 1234567.89, nil},
+		{"english without grouping", "1234567.89", language.English, 1234567.89, nil},
+		{"english decimal only", ".5", language.English, 0.5, nil},
+		{"english negative", "-1,234", language.English, -1234, nil},
+		{"english misplaced groups", "1,2,3", language.English, 0, ErrNumberSyntax},
+		{"english short group", "1,23", language.English, 0, ErrNumberSyntax},
+		{"english long first group", "1234,567", language.English, 0, ErrNumberSyntax},
+		{"english grouped fraction", "1.234,5", language.English, 0, ErrNumberSyntax},
+		{"portuguese", "1.234.567,89", language.BrazilianPortuguese, 1234567.89, nil},
+		{"portuguese thousand", "1.234", language.Portuguese, 1234, nil},
+		{"german", "12.345,6", language.German, 12345.6, nil},
+		{"french narrow no-break space", "1 234,5", language.French, 1234.5, nil},
+		{"spaces", "1 234 567", language.English, 1234567, nil},
+		{"thin spaces", "1 234,5", language.Portuguese, 1234.5, nil},
+		{"mixed separators", "1.234 567", language.Portuguese, 0, ErrNumberSyntax},
+		{"indian", "12,34,567.8", language.MustParse("en-IN"), 1234567.8, nil},
+		{"hindi crore", "1,23,45,678", language.Hindi, 12345678, nil},
+		{"indian thousand", "1,234", language.Hindi, 1234, nil},
+		{"indian misplaced groups", "1,234,567", language.Hindi, 0, ErrNumberSyntax},
+		{"exponent", "1.5E3", language.English, 1500, nil},
+		{"exponent localized", "1,5e-3", language.Portuguese, 0.0015, nil},
+		{"bad exponent", "1e", language.English, 0, ErrNumberSyntax},
+		{"surrounding whitespace", "  +42  ", language.English, 42, nil},
+		{"empty", "", language.English, 0, ErrNumberSyntax},
+		{"sign only", "-", language.English, 0, ErrNumberSyntax},
+		{"garbage", "12a", language.English, 0, ErrNumberSyntax},
+		{"overflow", "1e400", language.English, 0, ErrNumberRange},
+		{"unknown language", "1,234.5", language.Korean, 1234.5, nil},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			output, err := ParseNumber(tc.input, tc.lang)
+
+			if output != tc.expectedOutput || !errors.Is(err, tc.expectedError) || tc.expectedError == nil && err != nil {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, %v, \n\tGot: %v, %v", tc.input, tc.expectedOutput, tc.expectedError, output, err)
+			}
+		})
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          float64
+		lang           language.Tag
+		opts           NumberFormatOptions
+		expectedOutput string
+	}{
+		{"english integer", 1234567, language.English, NumberFormatOptions{}, "1,234,567"},
+		{"english precision", 1234567.891, language.English, NumberFormatOptions{Precision: 2}, "1,234,567.89"},
+		{"english exact", 1234.5678, language.English, NumberFormatOptions{Precision: -1}, "1,234.5678"},
+		{"padded precision", 1.5, language.English, NumberFormatOptions{Precision: 3}, "1.500"},
+		{"small", 999, language.English, NumberFormatOptions{}, "999"},
+		{"negative", -1234.5, language.English, NumberFormatOptions{Precision: 1}, "-1,234.5"},
+		{"negative zero", -0.001, language.English, NumberFormatOptions{Precision: 2}, "0.00"},
+		{"portuguese", 1234567.891, language.BrazilianPortuguese, NumberFormatOptions{Precision: 2}, "1.234.567,89"},
+		{"french", 1234567.5, language.French, NumberFormatOptions{Precision: 1}, "1 234 567,5"},
+		{"indian", 1234567, language.MustParse("en-IN"), NumberFormatOptions{}, "12,34,567"},
+		{"hindi crore", 123456789, language.Hindi, NumberFormatOptions{}, "12,34,56,789"},
+		{"indian grouping in english", 1234567, language.English, NumberFormatOptions{Grouping: NumberGroupingIndian}, "12,34,567"},
+		{"thousands grouping in hindi", 1234567, language.Hindi, NumberFormatOptions{Grouping: NumberGroupingThousands}, "1,234,567"},
+		{"no grouping", 1234567, language.English, NumberFormatOptions{Grouping: NumberGroupingNone}, "1234567"},
+		{"thin space", 1234567.5, language.English, NumberFormatOptions{Precision: 1, GroupSeparator: " "}, "1 234 567.5"},
+		{"half even down", 2.5, language.English, NumberFormatOptions{}, "2"},
+		{"half even up", 3.5, language.English, NumberFormatOptions{}, "4"},
+		{"half up", 2.5, language.English, NumberFormatOptions{Rounding: NumberRoundHalfUp}, "3"},
+		{"half up decimal", 1.005, language.English, NumberFormatOptions{Precision: 2, Rounding: NumberRoundHalfUp}, "1.01"},
+		{"half up negative", -2.5, language.English, NumberFormatOptions{Rounding: NumberRoundHalfUp}, "-3"},
+		{"half down", 2.5, language.English, NumberFormatOptions{Rounding: NumberRoundHalfDown}, "2"},
+		{"half down above tie", 2.51, language.English, NumberFormatOptions{Rounding: NumberRoundHalfDown}, "3"},
+		{"up", 2.01, language.English, NumberFormatOptions{Rounding: NumberRoundUp}, "3"},
+		{"down", 2.99, language.English, NumberFormatOptions{Rounding: NumberRoundDown}, "2"},
+		{"ceiling negative", -2.9, language.English, NumberFormatOptions{Rounding: NumberRoundCeiling}, "-2"},
+		{"floor negative", -2.1, language.English, NumberFormatOptions{Rounding: NumberRoundFloor}, "-3"},
+		{"carry", 999999.99, language.English, NumberFormatOptions{Precision: 1}, "1,000,000.0"},
+		{"percent", 0.256, language.English, NumberFormatOptions{Percent: true, Precision: 1}, "25.6%"},
+		{"percent exact", 0.07, language.English, NumberFormatOptions{Percent: true, Precision: -1}, "7%"},
+		{"percent exact fraction", 1.1, language.English, NumberFormatOptions{Percent: true, Precision: -1}, "110%"},
+		{"percent exact small", 0.00125, language.English, NumberFormatOptions{Percent: true, Precision: -1}, "0.125%"},
+		{"percent negative", -0.5, language.English, NumberFormatOptions{Percent: true}, "-50%"},
+		{"percent scientific", 0.07, language.English, NumberFormatOptions{Percent: true, Scientific: true, Precision: -1}, "7E0%"},
+		{"percent german", 0.5, language.German, NumberFormatOptions{Percent: true}, "50 %"},
+		{"scientific", 12345, language.English, NumberFormatOptions{Scientific: true, Precision: 2}, "1.23E4"},
+		{"scientific small", 0.00012345, language.Portuguese, NumberFormatOptions{Scientific: true, Precision: 3}, "1,234E-4"},
+		{"scientific carry", 9.999, language.English, NumberFormatOptions{Scientific: true, Precision: 2}, "1.00E1"},
+		{"scientific exact", -1500, language.English, NumberFormatOptions{Scientific: true, Precision: -1}, "-1.5E3"},
+		{"scientific zero", 0, language.English, NumberFormatOptions{Scientific: true, Precision: 1}, "0.0E0"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			output := FormatNumber(tc.input, tc.lang, tc.opts)
+
+			if output != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %v,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,518 @@
+package stringo
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// PersonName is a person name split into its parts, as returned by ParseName
+// Parts are kept as written, so capitalization and accents are preserved.
+type PersonName struct {
+	// Prefix holds titles and honorifics, like "Dr.", "Sr." and "Prof. Dr."
+	Prefix string
+	// Given is the first given name
+	Given string
+	// Middle holds the other given names, between the first given name and the surname
+	Middle []string
+	// MaternalSurname is the mother's family name of Portuguese names, which comes before the father's, like "Lula" in "Lula da Silva".
+	// Its particles are kept with it, as in "dos Santos". The father's family name is Surname, which is the one used for sorting
+	MaternalSurname string
+	// Particle is the lowercase word, or words, starting the surname, like "da", "van" and "von der"
+	Particle string
+	// Surname is the family name, without the particle. Compound surnames, like "García Márquez" and "Silva e Souza", are kept together
+	Surname string
+	// Suffix holds generational and academic suffixes, like "Jr.", "Filho", "Neto", "III" and "PhD"
+	Suffix string
+	// FamilyFirst means the family name comes first, as in Chinese, Japanese, Korean, Vietnamese and Hungarian names
+	FamilyFirst bool
+}
+
+// namePrefixes are the titles and honorifics recognized by ParseName, lowercase and without the trailing period
+var namePrefixes = wordSet("dr", "dra", "sr", "sra", "srta", "mr", "mrs", "ms", "miss", "mx", "prof", "profa", "rev", "fr",
+	"sir", "dame", "lord", "lady", "eng", "don", "doña", "dom", "dona", "mme", "mlle", "herr", "frau")
+
+// nameSuffixes are the generational and academic suffixes recognized by ParseName, lowercase and without the trailing period
+var nameSuffixes = wordSet("jr", "júnior", "junior", "sr", "filho", "filha", "neto", "neta", "sobrinho", "sobrinha",
+	"ii", "iii", "iv", "vi", "vii", "viii", "ix", "phd", "ph.d", "md", "m.d", "esq", "dds", "mba")
+
+// nameConjunctions join surnames, as in "Ortega y Gasset" and "Silva e Souza"
+var nameConjunctions = wordSet("e", "y", "i")
+
+// nameCompoundSurnamesChinese are the two characters Chinese surnames, which ParseName recognizes in unspaced names
+var nameCompoundSurnamesChinese = wordSet("欧阳", "司马", "上官", "诸葛", "东方", "皇甫", "尉迟", "公孙", "慕容", "令狐", "司徒", "夏侯", "歐陽", "司馬", "諸葛")
+
+// nameWordKey returns the lowercase word, without the trailing period, for prefix, suffix and particle lookup
+func nameWordKey(word string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimRight(word, ",")), ".")
+}
+
+// isNameParticle tells if the word is a surname particle, like "da" or "van", or a conjunction
+func isNameParticle(word string) bool {
+	key := nameWordKey(word)
+
+	return nameParticles[key] || nameConjunctions[key]
+}
+
+// nameFamilyFirst tells if names are written family name first in the given language
+func nameFamilyFirst(lang language.Tag) bool {
+	base, _ := lang.Base()
+
+	switch base.String() {
+	case "zh", "ja", "ko", "vi", "hu":
+		return true
+	}
+
+	return false
+}
+
+// splitNameAffixes strips prefixes from the start, and suffixes from the end, of the given words
+// A single word is never taken as a prefix or suffix.
+func splitNameAffixes(words []string) (prefixes, rest, suffixes []string) {
+	for len(words) > 1 && namePrefixes[nameWordKey(words[0])] {
+		prefixes = append(prefixes, words[0])
+		words = words[1:]
+	}
+
+	for len(words) > 1 && nameSuffixes[nameWordKey(words[len(words)-1])] {
+		suffixes = append([]string{words[len(words)-1]}, suffixes...)
+		words = words[:len(words)-1]
+	}
+
+	return prefixes, words, suffixes
+}
+
+// surnameStart returns where the surname starts in the given words, never before from
+// A surname group is a word, along with its particles, and conjunctions join groups. Spanish takes two groups,
+// as long as a given name is left before them.
+func surnameStart(words []string, from int, groups int) int {
+	start := len(words)
+
+	for g := 0; g < groups && start-1 >= from; g++ {
+		// The surname word itself
+		start--
+
+		for start-1 >= from && isNameParticle(words[start-1]) {
+			start--
+
+			// "Silva e Souza" and "Ortega y Gasset" join the previous group
+			if nameConjunctions[nameWordKey(words[start])] && start-1 >= from {
+				start--
+			}
+		}
+	}
+
+	return start
+}
+
+// splitParticle splits the leading particles of a surname from the rest
+func splitParticle(words []string) (string, string) {
+	i := 0
+
+	for i < len(words)-1 && nameParticles[nameWordKey(words[i])] {
+		i++
+	}
+
+	return strings.Join(words[:i], " "), strings.Join(words[i:], " ")
+}
+
+// parseUnspacedName splits Chinese and Korean names written without spaces, like "毛泽东", into surname and given name
+func parseUnspacedName(word string, pn *PersonName) bool {
+	r, _ := utf8.DecodeRuneInString(word)
+
+	if !unicode.In(r, unicode.Han, unicode.Hangul) || utf8.RuneCountInString(word) < 2 || utf8.RuneCountInString(word) > 4 {
+		return false
+	}
+
+	runes := []rune(word)
+	n := 1
+
+	if len(runes) > 2 && nameCompoundSurnamesChinese[string(runes[:2])] {
+		n = 2
+	}
+
+	pn.Surname, pn.Given = string(runes[:n]), string(runes[n:])
+
+	return true
+}
+
+// parseWesternName fills the given and family name parts from words in given name first order
+func parseWesternName(words []string, lang language.Tag, pn *PersonName) {
+	if len(words) == 1 {
+		pn.Given = words[0]
+		return
+	}
+
+	from := 1
+
+	// Just a surname with particle, like "van Gogh"
+	if isNameParticle(words[0]) {
+		from = 0
+	}
+
+	base, _ := lang.Base()
+	groups := 1
+
+	if base.String() == "es" || base.String() == "pt" {
+		groups = 2
+	}
+
+	start := surnameStart(words, from, groups)
+	family := start
+
+	// Portuguese names take the mother's surname, then the father's
+	if base.String() == "pt" {
+		family = surnameStart(words, start, 1)
+		pn.MaternalSurname = strings.Join(words[start:family], " ")
+	}
+
+	if from == 1 {
+		pn.Given = words[0]
+	}
+
+	if start > from {
+		pn.Middle = append([]string(nil), words[from:start]...)
+	}
+
+	pn.Particle, pn.Surname = splitParticle(words[family:])
+}
+
+// ParseName splits a person name into prefix, given name, middle names, surname particle, surname and suffix, according the locale rules
+// Surname particles, like "da", "van" and "von der", stay with the surname. Spanish names take two surnames, paternal and maternal,
+// like "García Márquez". Portuguese names take two too, maternal and paternal, split into MaternalSurname and Surname, so compound
+// given names, like "Ana Maria", need the maternal surname to be told apart. Other languages take the last word as surname, as middle
+// words may be either given names or family names, and surnames joined by "e" or "y", like "Silva e Souza", are kept together.
+// Chinese, Japanese, Korean, Vietnamese and Hungarian names are read family name first, and the "Surname, Given" form is accepted too.
+// Example: ParseName("Dr. Luiz Inácio Lula da Silva Filho", language.Portuguese) returns
+// PersonName{Prefix: "Dr.", Given: "Luiz", Middle: []string{"Inácio"}, MaternalSurname: "Lula", Particle: "da", Surname: "Silva", Suffix: "Filho"}
+func ParseName(s string, lang language.Tag) PersonName {
+	var pn PersonName
+
+	parts := strings.Split(s, ",")
+
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	// "Smith, Jr." and "Smith, PhD" aren't the "Surname, Given" form
+	suffixOnly := func(part string) bool {
+		words := strings.Fields(part)
+
+		for _, w := range words {
+			if !nameSuffixes[nameWordKey(w)] {
+				return false
+			}
+		}
+
+		return len(words) > 0
+	}
+
+	if len(parts) > 1 && parts[1] != "" && !suffixOnly(parts[1]) {
+		// Surname, Given Middle[, Suffix]
+		given := strings.Fields(parts[1])
+		prefixes, given, suffixes := splitNameAffixes(given)
+
+		for _, p := range parts[2:] {
+			suffixes = append(suffixes, strings.Fields(p)...)
+		}
+
+		pn.Prefix, pn.Suffix = strings.Join(prefixes, " "), strings.Join(suffixes, " ")
+		pn.Particle, pn.Surname = splitParticle(strings.Fields(parts[0]))
+
+		if len(given) > 0 {
+			pn.Given, pn.Middle = given[0], given[1:]
+		}
+
+		if len(pn.Middle) == 0 {
+			pn.Middle = nil
+		}
+
+		return pn
+	}
+
+	words := strings.Fields(strings.Join(parts, " "))
+
+	if len(words) == 0 {
+		return pn
+	}
+
+	prefixes, words, suffixes := splitNameAffixes(words)
+	pn.Prefix, pn.Suffix = strings.Join(prefixes, " "), strings.Join(suffixes, " ")
+
+	if !nameFamilyFirst(lang) {
+		parseWesternName(words, lang, &pn)
+		return pn
+	}
+
+	pn.FamilyFirst = true
+
+	if len(words) == 1 {
+		if !parseUnspacedName(words[0], &pn) {
+			pn.Surname = words[0]
+		}
+
+		return pn
+	}
+
+	pn.Surname = words[0]
+
+	// Vietnamese given name is the last word, after middle names, like "Nguyễn Văn An"
+	if base, _ := lang.Base(); base.String() == "vi" {
+		pn.Given = words[len(words)-1]
+
+		if len(words) > 2 {
+			pn.Middle = append([]string(nil), words[1:len(words)-1]...)
+		}
+
+		return pn
+	}
+
+	pn.Given = strings.Join(words[1:], " ")
+
+	return pn
+}
+
+type CitationStyle uint8
+
+const (
+	// CitationAPA is the American Psychological Association style. I.E: "Nietzsche, F. W."
+	CitationAPA CitationStyle = 0
+	// CitationABNT is the Brazilian ABNT NBR 6023 style. I.E: "NIETZSCHE, Friedrich W."
+	CitationABNT CitationStyle = 1
+	// CitationMLA is the Modern Language Association style. I.E: "Nietzsche, Friedrich Wilhelm"
+	CitationMLA CitationStyle = 2
+)
+
+// NameInitialsOptions parametrizes PersonName.Initials. The zero value takes given, middle and surname initials, without dots.
+type NameInitialsOptions struct {
+	// GivenOnly leaves the surname out
+	GivenOnly bool
+	// IncludeParticles takes particles initials too, lowercase, like the "v" in "L. v. B."
+	IncludeParticles bool
+	// Dotted follows each initial with a period
+	Dotted bool
+	// Spaced separates initials with spaces
+	Spaced bool
+}
+
+// nameGreetings are the greeting formats used by PersonName.Greeting, by language. They're gender-neutral on purpose.
+var nameGreetings = map[string]string{
+	"en": "Dear %s",
+	"pt": "Olá, %s",
+	"es": "Hola, %s",
+	"fr": "Bonjour %s",
+	"de": "Hallo %s",
+	"it": "Salve %s",
+}
+
+// nameInitial returns the first letter of the word, uppercased
+func nameInitial(word string) string {
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			return string(unicode.ToUpper(r))
+		}
+	}
+
+	return ""
+}
+
+// joinNonEmpty joins the non empty parts with the given separator
+func joinNonEmpty(sep string, parts ...string) string {
+	var kept []string
+
+	for _, p := range parts {
+		if p != "" {
+			kept = append(kept, p)
+		}
+	}
+
+	return strings.Join(kept, sep)
+}
+
+// FamilyName returns the surname along with its particle, and the maternal surname, if any. I.E: "van Beethoven" and "Lula da Silva"
+func (pn PersonName) FamilyName() string {
+	return joinNonEmpty(" ", pn.MaternalSurname, pn.Particle, pn.Surname)
+}
+
+// GivenNames returns the given name along with the middle names. I.E: "Friedrich Wilhelm"
+func (pn PersonName) GivenNames() string {
+	return joinNonEmpty(" ", append([]string{pn.Given}, pn.Middle...)...)
+}
+
+// middleInitials returns the middle names initials, dotted and separated by spaces. I.E: "W." for "Wilhelm"
+func (pn PersonName) middleInitials() string {
+	initials := make([]string, 0, len(pn.Middle))
+
+	for _, m := range pn.Middle {
+		if i := nameInitial(m); i != "" {
+			initials = append(initials, i+".")
+		}
+	}
+
+	return strings.Join(initials, " ")
+}
+
+// maternalInitials returns the maternal surname initials, dotted and separated by spaces, without particles. I.E: "S." for "dos Santos"
+func (pn PersonName) maternalInitials() string {
+	var initials []string
+
+	for _, w := range strings.Fields(pn.MaternalSurname) {
+		if i := nameInitial(w); i != "" && !isNameParticle(w) {
+			initials = append(initials, i+".")
+		}
+	}
+
+	return strings.Join(initials, " ")
+}
+
+// String returns the full name, in its natural order, with prefix and suffix
+// Example: "Dr. Martin Luther King Jr.", or "Mao Zedong" for family first names
+func (pn PersonName) String() string {
+	if pn.FamilyFirst {
+		return joinNonEmpty(" ", pn.Prefix, pn.FamilyName(), pn.GivenNames(), pn.Suffix)
+	}
+
+	return joinNonEmpty(" ", pn.Prefix, pn.GivenNames(), pn.FamilyName(), pn.Suffix)
+}
+
+// LastFirst returns the name as "Surname, Given M.", for sorted lists, with particles after the given names and the suffix at the end
+// Example: "Nietzsche, Friedrich W.", "Beethoven, Ludwig van" and "King, Martin L., Jr."
+func (pn PersonName) LastFirst() string {
+	if pn.Surname == "" {
+		return pn.GivenNames()
+	}
+
+	given := joinNonEmpty(" ", pn.Given, pn.middleInitials(), pn.maternalInitials(), pn.Particle)
+
+	return joinNonEmpty(", ", pn.Surname, given, pn.Suffix)
+}
+
+// InitialsFirst returns the name as "G. M. Surname", with particles and suffix kept
+// Example: "F. W. Nietzsche" and "L. van Beethoven"
+func (pn PersonName) InitialsFirst() string {
+	given := ""
+
+	if i := nameInitial(pn.Given); i != "" {
+		given = i + "."
+	}
+
+	return joinNonEmpty(" ", given, pn.middleInitials(), pn.FamilyName(), pn.Suffix)
+}
+
+// Citation returns the name as an author, in the given bibliographic citation style
+// ABNT uppercases the surname, and keeps generational suffixes with it, like "SILVA FILHO, Luiz". APA takes only initials of given names.
+// Example: Citation(CitationAPA) returns "Nietzsche, F. W.", and Citation(CitationABNT) returns "NIETZSCHE, Friedrich W."
+func (pn PersonName) Citation(style CitationStyle) string {
+	if pn.Surname == "" {
+		return pn.GivenNames()
+	}
+
+	switch style {
+	case CitationABNT:
+		surname := strings.ToUpper(joinNonEmpty(" ", pn.Surname, pn.Suffix))
+
+		return joinNonEmpty(", ", surname, joinNonEmpty(" ", pn.Given, pn.middleInitials(), pn.maternalInitials(), pn.Particle))
+	case CitationMLA:
+		return joinNonEmpty(", ", pn.Surname, joinNonEmpty(" ", pn.GivenNames(), pn.MaternalSurname, pn.Particle), pn.Suffix)
+	}
+
+	given := ""
+
+	if i := nameInitial(pn.Given); i != "" {
+		given = i + "."
+	}
+
+	return joinNonEmpty(", ", pn.Surname, joinNonEmpty(" ", given, pn.middleInitials(), pn.maternalInitials(), pn.Particle), pn.Suffix)
+}
+
+// Greeting returns a gender-neutral greeting, in the given language, for letters and emails
+// With a prefix, the greeting is formal, like "Dear Dr. Nietzsche". Otherwise, it uses the given name and surname.
+// Supported languages are English, Portuguese, Spanish, French, German and Italian. Others fall back to English.
+// Example: ParseName("Dr. Friedrich Nietzsche", language.English).Greeting(language.English) returns "Dear Dr. Nietzsche"
+func (pn PersonName) Greeting(lang language.Tag) string {
+	base, _ := lang.Base()
+	format, ok := nameGreetings[base.String()]
+
+	if !ok {
+		format = nameGreetings["en"]
+	}
+
+	var name string
+
+	// the maternal surname is left out, as in "Luiz da Silva"
+	family := joinNonEmpty(" ", pn.Particle, pn.Surname)
+
+	switch {
+	case pn.Prefix != "" && pn.Surname != "":
+		name = pn.Prefix + " " + family
+	case pn.FamilyFirst:
+		name = joinNonEmpty(" ", family, pn.Given)
+	default:
+		name = joinNonEmpty(" ", pn.Prefix, pn.Given, family)
+	}
+
+	return fmt.Sprintf(format, name)
+}
+
+// Initials returns the name initials, according the given options. Prefix and suffix are left out.
+// Every word of compound surnames counts, as well as every part of hyphenated names, but conjunctions, like "e" and "y", never do.
+// Example: for "Ludwig van Beethoven", Initials(NameInitialsOptions{}) returns "LB", and with IncludeParticles and Dotted, "L.v.B."
+func (pn PersonName) Initials(opts NameInitialsOptions) string {
+	var initials []string
+
+	add := func(words []string, particles bool) {
+		for _, w := range words {
+			key := nameWordKey(w)
+
+			if nameConjunctions[key] {
+				continue
+			}
+
+			for _, part := range strings.Split(w, "-") {
+				i := nameInitial(part)
+
+				if i == "" {
+					continue
+				}
+
+				if particles || nameParticles[key] {
+					if !opts.IncludeParticles {
+						continue
+					}
+
+					i = strings.ToLower(i)
+				}
+
+				if opts.Dotted {
+					i += "."
+				}
+
+				initials = append(initials, i)
+			}
+		}
+	}
+
+	given := append([]string{pn.Given}, pn.Middle...)
+	family := strings.Fields(pn.Surname)
+
+	if pn.FamilyFirst && !opts.GivenOnly {
+		add(family, false)
+	}
+
+	add(given, false)
+
+	if !pn.FamilyFirst && !opts.GivenOnly {
+		add(strings.Fields(pn.MaternalSurname), false)
+		add(strings.Fields(pn.Particle), true)
+		add(family, false)
+	}
+
+	if opts.Spaced {
+		return strings.Join(initials, " ")
+	}
+
+	return strings.Join(initials, "")
+}
@@ -0,0 +1,129 @@
+package stringo
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParseName(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		lang           language.Tag
+		expectedOutput PersonName
+	}{
+		{"empty", "  ", language.Und, PersonName{}},
+		{"single name", "Madonna", language.Und, PersonName{Given: "Madonna"}},
+		{"given and surname", "Friedrich Nietzsche", language.German, PersonName{Given: "Friedrich", Surname: "Nietzsche"}},
+		{"middle names", "Friedrich Wilhelm Nietzsche", language.German, PersonName{Given: "Friedrich", Middle: []string{"Wilhelm"}, Surname: "Nietzsche"}},
+		{"particle", "Ludwig van Beethoven", language.German, PersonName{Given: "Ludwig", Particle: "van", Surname: "Beethoven"}},
+		{"compound particle", "Ursula von der Leyen", language.German, PersonName{Given: "Ursula", Particle: "von der", Surname: "Leyen"}},
+		{"prefix and suffix", "Dr. Luiz Inácio Lula da Silva Filho", language.Portuguese,
+			PersonName{Prefix: "Dr.", Given: "Luiz", Middle: []string{"Inácio"}, MaternalSurname: "Lula", Particle: "da", Surname: "Silva", Suffix: "Filho"}},
+		{"many prefixes", "Prof. Dr. Ana Souza", language.Portuguese, PersonName{Prefix: "Prof. Dr.", Given: "Ana", Surname: "Souza"}},
+		{"roman suffix", "John Smith III", language.English, PersonName{Given: "John", Surname: "Smith", Suffix: "III"}},
+		{"comma suffix", "Martin Luther King, Jr.", language.English, PersonName{Given: "Martin", Middle: []string{"Luther"}, Surname: "King", Suffix: "Jr."}},
+		{"joined surnames", "Maria Silva e Souza", language.Portuguese, PersonName{Given: "Maria", Surname: "Silva e Souza"}},
+		{"portuguese double surname", "Maria dos Santos da Costa", language.Portuguese,
+			PersonName{Given: "Maria", MaternalSurname: "dos Santos", Particle: "da", Surname: "Costa"}},
+		{"portuguese middle name", "João Carlos Oliveira Pereira", language.BrazilianPortuguese,
+			PersonName{Given: "João", Middle: []string{"Carlos"}, MaternalSurname: "Oliveira", Surname: "Pereira"}},
+		{"portuguese single surname", "Ana Souza", language.Portuguese, PersonName{Given: "Ana", Surname: "Souza"}},
+		{"portuguese compound given name", "Ana Maria Costa Souza", language.Portuguese,
+			PersonName{Given: "Ana", Middle: []string{"Maria"}, MaternalSurname: "Costa", Surname: "Souza"}},
+		{"portuguese maternal surname like a given name", "Ana Rosa Lima", language.Portuguese, PersonName{Given: "Ana", MaternalSurname: "Rosa", Surname: "Lima"}},
+		{"portuguese maternal surname with particle like a given name", "Maria de Jesus Silva", language.Portuguese,
+			PersonName{Given: "Maria", MaternalSurname: "de Jesus", Surname: "Silva"}},
+		{"spanish double surname", "Gabriel García Márquez", language.Spanish, PersonName{Given: "Gabriel", Surname: "García Márquez"}},
+		{"spanish middle name", "Gabriel José García Márquez", language.Spanish, PersonName{Given: "Gabriel", Middle: []string{"José"}, Surname: "García Márquez"}},
+		{"spanish single surname", "Gabriel Márquez", language.Spanish, PersonName{Given: "Gabriel", Surname: "Márquez"}},
+		{"spanish conjunction", "José Ortega y Gasset", language.Spanish, PersonName{Given: "José", Surname: "Ortega y Gasset"}},
+		{"spanish particles", "José de la Cruz Fernández", language.Spanish, PersonName{Given: "José", Particle: "de la", Surname: "Cruz Fernández"}},
+		{"surname only", "van Gogh", language.Dutch, PersonName{Particle: "van", Surname: "Gogh"}},
+		{"comma form", "Nietzsche, Friedrich Wilhelm", language.German, PersonName{Given: "Friedrich", Middle: []string{"Wilhelm"}, Surname: "Nietzsche"}},
+		{"comma form with particle and suffix", "van Buren, Mr. Martin, Jr.", language.English,
+			PersonName{Prefix: "Mr.", Given: "Martin", Particle: "van", Surname: "Buren", Suffix: "Jr."}},
+		{"chinese romanized", "Mao Zedong", language.Chinese, PersonName{Given: "Zedong", Surname: "Mao", FamilyFirst: true}},
+		{"chinese unspaced", "毛泽东", language.Chinese, PersonName{Given: "泽东", Surname: "毛", FamilyFirst: true}},
+		{"chinese compound surname", "欧阳修", language.Chinese, PersonName{Given: "修", Surname: "欧阳", FamilyFirst: true}},
+		{"korean unspaced", "김민준", language.Korean, PersonName{Given: "민준", Surname: "김", FamilyFirst: true}},
+		{"hungarian", "Bartók Béla", language.Hungarian, PersonName{Given: "Béla", Surname: "Bartók", FamilyFirst: true}},
+		{"vietnamese", "Nguyễn Văn An", language.Vietnamese, PersonName{Given: "An", Middle: []string{"Văn"}, Surname: "Nguyễn", FamilyFirst: true}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := ParseName(tc.input, tc.lang)
+
+			if !reflect.DeepEqual(r, tc.expectedOutput) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %#v, \n\tGot: %#v", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestPersonNameFormatting(t *testing.T) {
+	var (
+		nietzsche = ParseName("Dr. Friedrich Wilhelm Nietzsche", language.German)
+		beethoven = ParseName("Ludwig van Beethoven", language.German)
+		king      = ParseName("Martin Luther King, Jr.", language.English)
+		lula      = ParseName("Luiz Inácio Lula da Silva Filho", language.Portuguese)
+		marquez   = ParseName("Gabriel José García Márquez", language.Spanish)
+		costa     = ParseName("Maria dos Santos da Costa", language.Portuguese)
+		mao       = ParseName("Mao Zedong", language.Chinese)
+		madonna   = ParseName("Madonna", language.English)
+	)
+
+	tcs := []struct {
+		summary        string
+		fn             func() string
+		expectedOutput string
+	}{
+		{"string", nietzsche.String, "Dr. Friedrich Wilhelm Nietzsche"},
+		{"string family first", mao.String, "Mao Zedong"},
+		{"string single name", madonna.String, "Madonna"},
+		{"last first", nietzsche.LastFirst, "Nietzsche, Friedrich W."},
+		{"last first particle", beethoven.LastFirst, "Beethoven, Ludwig van"},
+		{"last first suffix", king.LastFirst, "King, Martin L., Jr."},
+		{"last first single name", madonna.LastFirst, "Madonna"},
+		{"initials first", nietzsche.InitialsFirst, "F. W. Nietzsche"},
+		{"initials first particle", beethoven.InitialsFirst, "L. van Beethoven"},
+		{"apa", func() string { return nietzsche.Citation(CitationAPA) }, "Nietzsche, F. W."},
+		{"apa suffix", func() string { return king.Citation(CitationAPA) }, "King, M. L., Jr."},
+		{"abnt", func() string { return nietzsche.Citation(CitationABNT) }, "NIETZSCHE, Friedrich W."},
+		{"abnt suffix and particle", func() string { return lula.Citation(CitationABNT) }, "SILVA FILHO, Luiz I. L. da"},
+		{"abnt compound surname", func() string { return marquez.Citation(CitationABNT) }, "GARCÍA MÁRQUEZ, Gabriel J."},
+		{"mla", func() string { return nietzsche.Citation(CitationMLA) }, "Nietzsche, Friedrich Wilhelm"},
+		{"greeting formal", func() string { return nietzsche.Greeting(language.English) }, "Dear Dr. Nietzsche"},
+		{"greeting informal", func() string { return beethoven.Greeting(language.German) }, "Hallo Ludwig van Beethoven"},
+		{"greeting portuguese", func() string { return lula.Greeting(language.Portuguese) }, "Olá, Luiz da Silva"},
+		{"string maternal surname", lula.String, "Luiz Inácio Lula da Silva Filho"},
+		{"family name maternal surname", costa.FamilyName, "dos Santos da Costa"},
+		{"last first maternal surname", costa.LastFirst, "Costa, Maria S. da"},
+		{"initials first maternal surname", costa.InitialsFirst, "M. dos Santos da Costa"},
+		{"apa maternal surname", func() string { return costa.Citation(CitationAPA) }, "Costa, M. S. da"},
+		{"mla maternal surname", func() string { return costa.Citation(CitationMLA) }, "Costa, Maria dos Santos da"},
+		{"greeting maternal surname", func() string { return costa.Greeting(language.Portuguese) }, "Olá, Maria da Costa"},
+		{"initials maternal surname", func() string { return costa.Initials(NameInitialsOptions{}) }, "MSC"},
+		{"greeting family first", func() string { return mao.Greeting(language.Und) }, "Dear Mao Zedong"},
+		{"initials", func() string { return nietzsche.Initials(NameInitialsOptions{}) }, "FWN"},
+		{"initials dotted spaced", func() string { return nietzsche.Initials(NameInitialsOptions{Dotted: true, Spaced: true}) }, "F. W. N."},
+		{"initials given only", func() string { return nietzsche.Initials(NameInitialsOptions{GivenOnly: true}) }, "FW"},
+		{"initials without particles", func() string { return beethoven.Initials(NameInitialsOptions{}) }, "LB"},
+		{"initials with particles", func() string { return beethoven.Initials(NameInitialsOptions{IncludeParticles: true, Dotted: true}) }, "L.v.B."},
+		{"initials compound surname", func() string { return marquez.Initials(NameInitialsOptions{}) }, "GJGM"},
+		{"initials family first", func() string { return mao.Initials(NameInitialsOptions{}) }, "MZ"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := tc.fn()
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tExpected: %q, \n\tGot: %q", tc.expectedOutput, r)
+			}
+		})
+	}
+}
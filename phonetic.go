@@ -0,0 +1,451 @@
+package stringo
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+type PhoneticAlgorithm uint8
+
+const (
+	// PhoneticSoundex is the American Soundex, as used by the US census. I.E: "Robert" and "Rupert" give "R163"
+	PhoneticSoundex PhoneticAlgorithm = 0
+	// PhoneticRefinedSoundex is a Soundex variant with more letter groups, and no length limit
+	PhoneticRefinedSoundex PhoneticAlgorithm = 1
+	// PhoneticDoubleMetaphone handles English, as well as many European and Asian names, with a primary and an alternate key
+	PhoneticDoubleMetaphone PhoneticAlgorithm = 2
+	// PhoneticNYSIIS is the New York State Identification and Intelligence System code
+	PhoneticNYSIIS PhoneticAlgorithm = 3
+	// PhoneticCologne is the Kölner Phonetik, tailored for German names
+	PhoneticCologne PhoneticAlgorithm = 4
+	// PhoneticBuscaBR is tailored for Brazilian Portuguese names. I.E: "Thiago" and "Tiago", "Luiz" and "Luis"
+	PhoneticBuscaBR PhoneticAlgorithm = 5
+)
+
+// phoneticUpper uppercases the given string, transliterating it to ASCII letters and dropping anything else but spaces
+// Runes accepted by keep, like "Ç" for Double Metaphone, are kept as they are, uppercased.
+func phoneticUpper(s string, keep func(rune) bool) string {
+	var sb strings.Builder
+
+	for _, r := range s {
+		u := unicode.ToUpper(r)
+
+		if keep != nil && keep(u) {
+			sb.WriteRune(u)
+			continue
+		}
+
+		if unicode.IsSpace(r) {
+			sb.WriteByte(' ')
+			continue
+		}
+
+		for _, t := range strings.ToUpper(Transliterate(string(r), language.Und)) {
+			if t >= 'A' && t <= 'Z' {
+				sb.WriteRune(t)
+			}
+		}
+	}
+
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// phoneticLetters is like phoneticUpper, but drops spaces too
+func phoneticLetters(s string) string {
+	return strings.ReplaceAll(phoneticUpper(s, nil), " ", "")
+}
+
+// soundexCodes are the Soundex digits of letters A to Z. Vowels, H, W and Y are 0
+const soundexCodes = "01230120022455012623010202"
+
+// Soundex returns the 4 characters American Soundex code of the given name, or "" if there are no letters in it
+// Accented and non Latin letters are transliterated first.
+// Example: Soundex("Robert") returns "R163", as well as Soundex("Rupert")
+func Soundex(s string) string {
+	s = phoneticLetters(s)
+
+	if s == "" {
+		return ""
+	}
+
+	code := []byte{s[0]}
+	last := soundexCodes[s[0]-'A']
+
+	for i := 1; i < len(s) && len(code) < 4; i++ {
+		d := soundexCodes[s[i]-'A']
+
+		if d != '0' && d != last {
+			code = append(code, d)
+		}
+
+		// H and W don't separate letters with the same code
+		if s[i] != 'H' && s[i] != 'W' {
+			last = d
+		}
+	}
+
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+
+	return string(code)
+}
+
+// refinedSoundexCodes are the Refined Soundex digits of letters A to Z
+const refinedSoundexCodes = "01360240043788015936020505"
+
+// RefinedSoundex returns the Refined Soundex code of the given name, or "" if there are no letters in it
+// Unlike Soundex, the code has no length limit, and vowels are kept as 0, so it's more selective.
+// Example: RefinedSoundex("Braz") returns "B1905"
+func RefinedSoundex(s string) string {
+	s = phoneticLetters(s)
+
+	if s == "" {
+		return ""
+	}
+
+	code := []byte{s[0]}
+	last := byte(0)
+
+	for i := 0; i < len(s); i++ {
+		d := refinedSoundexCodes[s[i]-'A']
+
+		if d != last {
+			code = append(code, d)
+		}
+
+		last = d
+	}
+
+	return string(code)
+}
+
+// isPhoneticVowel returns true for A, E, I, O and U
+func isPhoneticVowel(c byte) bool {
+	return c == 'A' || c == 'E' || c == 'I' || c == 'O' || c == 'U'
+}
+
+// nysiisTranscode returns the replacement for the current letter of a name, given its neighbours
+func nysiisTranscode(prev, cur, next, afterNext byte) string {
+	switch {
+	case cur == 'E' && next == 'V':
+		return "AF"
+	case isPhoneticVowel(cur):
+		return "A"
+	case cur == 'Q':
+		return "G"
+	case cur == 'Z':
+		return "S"
+	case cur == 'M':
+		return "N"
+	case cur == 'K' && next == 'N':
+		return "NN"
+	case cur == 'K':
+		return "C"
+	case cur == 'S' && next == 'C' && afterNext == 'H':
+		return "SSS"
+	case cur == 'P' && next == 'H':
+		return "FF"
+	case cur == 'H' && (!isPhoneticVowel(prev) || !isPhoneticVowel(next)):
+		return string(prev)
+	case cur == 'W' && isPhoneticVowel(prev):
+		return string(prev)
+	}
+
+	return string(cur)
+}
+
+// NYSIIS returns the New York State Identification and Intelligence System code of the given name, up to 6 characters
+// Example: NYSIIS("Macintosh") returns "MCANT", and NYSIIS("Knuth") returns "NAT"
+func NYSIIS(s string) string {
+	s = phoneticLetters(s)
+
+	if s == "" {
+		return ""
+	}
+
+	for _, r := range [][2]string{{"MAC", "MCC"}, {"KN", "NN"}, {"K", "C"}, {"PH", "FF"}, {"PF", "FF"}, {"SCH", "SSS"}} {
+		if strings.HasPrefix(s, r[0]) {
+			s = r[1] + s[len(r[0]):]
+			break
+		}
+	}
+
+	for _, r := range [][2]string{{"EE", "Y"}, {"IE", "Y"}, {"DT", "D"}, {"RT", "D"}, {"RD", "D"}, {"NT", "D"}, {"ND", "D"}} {
+		if strings.HasSuffix(s, r[0]) {
+			s = s[:len(s)-len(r[0])] + r[1]
+			break
+		}
+	}
+
+	chars := []byte(s)
+	key := []byte{chars[0]}
+
+	for i := 1; i < len(chars); i++ {
+		next, afterNext := byte(' '), byte(' ')
+
+		if i < len(chars)-1 {
+			next = chars[i+1]
+		}
+
+		if i < len(chars)-2 {
+			afterNext = chars[i+2]
+		}
+
+		copy(chars[i:], nysiisTranscode(chars[i-1], chars[i], next, afterNext))
+
+		// only appended if it differs from the last character of the key
+		if chars[i] != key[len(key)-1] {
+			key = append(key, chars[i])
+		}
+	}
+
+	if len(key) > 1 && key[len(key)-1] == 'S' {
+		key = key[:len(key)-1]
+	}
+
+	if len(key) > 2 && string(key[len(key)-2:]) == "AY" {
+		key = append(key[:len(key)-2], 'Y')
+	}
+
+	if len(key) > 1 && key[len(key)-1] == 'A' {
+		key = key[:len(key)-1]
+	}
+
+	if len(key) > 6 {
+		key = key[:6]
+	}
+
+	return string(key)
+}
+
+// cologneCode returns the Kölner Phonetik digits of the letter at position i, given the whole word
+func cologneCode(s string, i int) string {
+	var prev, next byte
+
+	if i > 0 {
+		prev = s[i-1]
+	}
+
+	if i < len(s)-1 {
+		next = s[i+1]
+	}
+
+	switch c := s[i]; c {
+	case 'A', 'E', 'I', 'J', 'O', 'U', 'Y':
+		return "0"
+	case 'H':
+		return ""
+	case 'B':
+		return "1"
+	case 'P':
+		if next == 'H' {
+			return "3"
+		}
+
+		return "1"
+	case 'D', 'T':
+		if strings.IndexByte("CSZ", next) >= 0 {
+			return "8"
+		}
+
+		return "2"
+	case 'F', 'V', 'W':
+		return "3"
+	case 'G', 'K', 'Q':
+		return "4"
+	case 'C':
+		if i == 0 {
+			if strings.IndexByte("AHKLOQRUX", next) >= 0 {
+				return "4"
+			}
+
+			return "8"
+		}
+
+		if strings.IndexByte("AHKOQUX", next) >= 0 && prev != 'S' && prev != 'Z' {
+			return "4"
+		}
+
+		return "8"
+	case 'X':
+		if prev == 'C' || prev == 'K' || prev == 'Q' {
+			return "8"
+		}
+
+		return "48"
+	case 'L':
+		return "5"
+	case 'M', 'N':
+		return "6"
+	case 'R':
+		return "7"
+	}
+
+	// S and Z
+	return "8"
+}
+
+// Cologne returns the Kölner Phonetik code of the given name, which suits German names
+// Example: Cologne("Müller-Lüdenscheidt") returns "65752682", and Cologne("Meyer") gives the same "67" as Cologne("Maier")
+func Cologne(s string) string {
+	s = phoneticLetters(s)
+
+	var (
+		code []byte
+		last byte
+	)
+
+	for i := 0; i < len(s); i++ {
+		digits := cologneCode(s, i)
+
+		// H is ignored, but still separates letters with the same code
+		if digits == "" {
+			last = 0
+			continue
+		}
+
+		for j := 0; j < len(digits); j++ {
+			d := digits[j]
+
+			if d != last && (d != '0' || len(code) == 0) {
+				code = append(code, d)
+			}
+
+			last = d
+		}
+	}
+
+	return string(code)
+}
+
+// buscaBRReplacements are the BuscaBR substitutions, applied in order
+var buscaBRReplacements = []struct {
+	from []string
+	to   string
+}{
+	{[]string{"BL", "BR"}, "B"},
+	{[]string{"PH"}, "F"},
+	{[]string{"GL", "GR", "MG", "NG", "RG"}, "G"},
+	{[]string{"Y"}, "I"},
+	{[]string{"GE", "GI", "RJ", "MJ"}, "J"},
+	{[]string{"CA", "CO", "CU", "CK", "Q"}, "K"},
+	{[]string{"N"}, "M"},
+	// "ON" is covered by "N" to "M", then "OM"
+	{[]string{"AO", "AUM", "GM", "MD", "OM"}, "M"},
+	{[]string{"PR"}, "P"},
+	{[]string{"L"}, "R"},
+	{[]string{"CE", "CI", "CH", "CS", "C", "Z", "X"}, "S"},
+	{[]string{"TR", "TL", "CT", "RT", "ST", "PT"}, "T"},
+}
+
+// BuscaBR returns the BuscaBR phonetic key of the given name, which suits Brazilian Portuguese names
+// The name is uppercased and stripped of accents, with "Ç" as "S", then consonant groups which sound alike are merged,
+// silent endings are dropped, as well as vowels and "H", and repeated letters are collapsed. Endings are dropped first, so "-ão" is silent.
+// Example: BuscaBR("Thiago") and BuscaBR("Tiago") return "TG", and BuscaBR("Souza") and BuscaBR("Sousa") return "S"
+func BuscaBR(s string) string {
+	s = phoneticUpper(s, func(r rune) bool { return r == 'Ç' })
+	s = strings.ReplaceAll(s, "Ç", "S")
+
+	var words []string
+
+	for _, w := range strings.Fields(s) {
+		// Silent endings, before substitutions rewrite them
+		if len(w) > 2 && strings.HasSuffix(w, "AO") {
+			w = strings.TrimSuffix(w, "AO")
+		} else if len(w) > 1 && strings.IndexByte("SZRMNL", w[len(w)-1]) >= 0 {
+			w = w[:len(w)-1]
+		}
+
+		for _, r := range buscaBRReplacements {
+			for _, from := range r.from {
+				w = strings.ReplaceAll(w, from, r.to)
+			}
+		}
+
+		var key []byte
+
+		for i := 0; i < len(w); i++ {
+			if isPhoneticVowel(w[i]) || w[i] == 'H' || (len(key) > 0 && key[len(key)-1] == w[i]) {
+				continue
+			}
+
+			key = append(key, w[i])
+		}
+
+		if len(key) > 0 {
+			words = append(words, string(key))
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// PhoneticKeys returns the phonetic keys of the given name, according the given algorithm
+// Double Metaphone may return two keys, primary and alternate. The other algorithms return a single key.
+// No key is returned if the name has no letters.
+func PhoneticKeys(s string, algo PhoneticAlgorithm) []string {
+	var keys []string
+
+	switch algo {
+	case PhoneticRefinedSoundex:
+		keys = []string{RefinedSoundex(s)}
+	case PhoneticDoubleMetaphone:
+		primary, alternate := DoubleMetaphone(s)
+		keys = []string{primary}
+
+		if alternate != primary {
+			keys = append(keys, alternate)
+		}
+	case PhoneticNYSIIS:
+		keys = []string{NYSIIS(s)}
+	case PhoneticCologne:
+		keys = []string{Cologne(s)}
+	case PhoneticBuscaBR:
+		keys = []string{BuscaBR(s)}
+	default:
+		keys = []string{Soundex(s)}
+	}
+
+	if keys[0] == "" {
+		return nil
+	}
+
+	return keys
+}
+
+// NamesSoundAlike tells if the given names sound alike, according the given phonetic algorithm
+// Names are compared word by word, so they must have the same count of words, and each pair of words must share a phonetic key.
+// Example: NamesSoundAlike("Thiago Luiz", "Tiago Luis", PhoneticBuscaBR) returns true
+func NamesSoundAlike(a, b string, algo PhoneticAlgorithm) bool {
+	wa, wb := strings.Fields(a), strings.Fields(b)
+
+	if len(wa) == 0 || len(wa) != len(wb) {
+		return false
+	}
+
+	for i := range wa {
+		ka, kb := PhoneticKeys(wa[i], algo), PhoneticKeys(wb[i], algo)
+
+		if !sharesKey(ka, kb) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sharesKey returns true if any key is in both lists
+func sharesKey(a, b []string) bool {
+	for _, ka := range a {
+		for _, kb := range b {
+			if ka == kb {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package stringo
+
+import "testing"
+
+func TestPhoneticCodes(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		fn             func(string) string
+		input          string
+		expectedOutput string
+	}{
+		{"soundex empty", Soundex, "", ""},
+		{"soundex no letters", Soundex, "123", ""},
+		{"soundex robert", Soundex, "Robert", "R163"},
+		{"soundex rupert", Soundex, "Rupert", "R163"},
+		{"soundex h doesn't separate", Soundex, "Ashcraft", "A261"},
+		{"soundex vowels separate", Soundex, "Tymczak", "T522"},
+		{"soundex first letter code", Soundex, "Pfister", "P236"},
+		{"soundex padding", Soundex, "Lee", "L000"},
+		{"soundex accents", Soundex, "Müller", "M460"},
+		{"refined soundex", RefinedSoundex, "Braz", "B1905"},
+		{"refined soundex jumped", RefinedSoundex, "jumped", "J408106"},
+		{"nysiis macintosh", NYSIIS, "Macintosh", "MCANT"},
+		{"nysiis knuth", NYSIIS, "Knuth", "NAT"},
+		{"nysiis bishop", NYSIIS, "Bishop", "BASAP"},
+		{"nysiis carlson", NYSIIS, "Carlson", "CARLSA"},
+		{"nysiis truncates", NYSIIS, "Christopherson", "CRASTA"},
+		// vectors from the Apache Commons Codec NYSIIS table
+		{"nysiis table brian", NYSIIS, "Brian", "BRAN"},
+		{"nysiis table brown", NYSIIS, "Brown", "BRAN"},
+		{"nysiis table brun", NYSIIS, "Brun", "BRAN"},
+		{"nysiis table capp", NYSIIS, "Capp", "CAP"},
+		{"nysiis table cope", NYSIIS, "Cope", "CAP"},
+		{"nysiis table kipp", NYSIIS, "Kipp", "CAP"},
+		{"nysiis table dane", NYSIIS, "Dane", "DAN"},
+		{"nysiis table dean", NYSIIS, "Dean", "DAN"},
+		{"nysiis table dionne", NYSIIS, "Dionne", "DAN"},
+		{"nysiis table smith", NYSIIS, "Smith", "SNAT"},
+		{"nysiis table schmit", NYSIIS, "Schmit", "SNAT"},
+		{"nysiis table schmidt", NYSIIS, "Schmidt", "SNAD"},
+		{"nysiis table trueman", NYSIIS, "Trueman", "TRANAN"},
+		{"nysiis table truman", NYSIIS, "Truman", "TRANAN"},
+		{"nysiis table knight", NYSIIS, "Knight", "NAGT"},
+		{"nysiis table mitchell", NYSIIS, "Mitchell", "MATCAL"},
+		{"nysiis table o'daniel", NYSIIS, "O'Daniel", "ODANAL"},
+		{"cologne", Cologne, "Müller-Lüdenscheidt", "65752682"},
+		{"cologne wikipedia", Cologne, "Wikipedia", "3412"},
+		{"cologne meyer", Cologne, "Meyer", "67"},
+		{"cologne maier", Cologne, "Maier", "67"},
+		{"cologne x", Cologne, "Xaver", "4837"},
+		{"buscabr thiago", BuscaBR, "Thiago", "TG"},
+		{"buscabr tiago", BuscaBR, "Tiago", "TG"},
+		{"buscabr souza", BuscaBR, "Souza", "S"},
+		{"buscabr cedilla", BuscaBR, "Conceição", "KMS"},
+		{"buscabr words", BuscaBR, "Luiz Gonzaga", "R GMSG"},
+		{"buscabr silent ao ending", BuscaBR, "Falcão", "FRS"},
+		{"buscabr ao ending", BuscaBR, "Simão", "SM"},
+		{"buscabr on ending", BuscaBR, "Nilton", "MT"},
+		{"buscabr on ending after vowel", BuscaBR, "Gideon", "JD"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := tc.fn(tc.input)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestDoubleMetaphone(t *testing.T) {
+	tcs := []struct {
+		input             string
+		expectedPrimary   string
+		expectedAlternate string
+	}{
+		{"", "", ""},
+		{"Smith", "SM0", "XMT"},
+		{"Schmidt", "XMT", "SMT"},
+		{"Thompson", "TMPS", "TMPS"},
+		{"Thiago", "0K", "TK"},
+		{"Tiago", "XK", "XK"},
+		{"Jose", "HS", "HS"},
+		{"Knight", "NT", "NT"},
+		{"Michael", "MKL", "MXL"},
+		{"Xavier", "SF", "SFR"},
+		{"Gallegos", "KLKS", "KKS"},
+		{"Filipowicz", "FLPT", "FLPF"},
+		{"François", "FRNS", "FRNS"},
+		{"Peña", "PN", "PN"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			p, a := DoubleMetaphone(tc.input)
+
+			if p != tc.expectedPrimary || a != tc.expectedAlternate {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, %q \n\tGot: %q, %q", tc.input, tc.expectedPrimary, tc.expectedAlternate, p, a)
+			}
+		})
+	}
+}
+
+func TestNamesSoundAlike(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		a, b           string
+		algo           PhoneticAlgorithm
+		expectedOutput bool
+	}{
+		{"empty", "", "", PhoneticSoundex, false},
+		{"soundex", "Robert", "Rupert", PhoneticSoundex, true},
+		{"soundex different", "Robert", "Ashcraft", PhoneticSoundex, false},
+		{"word count", "Robert Smith", "Rupert", PhoneticSoundex, false},
+		{"double metaphone alternate", "Catherine", "Kathryn", PhoneticDoubleMetaphone, true},
+		{"double metaphone smith", "John Smith", "Jon Schmidt", PhoneticDoubleMetaphone, true},
+		{"buscabr", "Thiago Luiz", "Tiago Luis", PhoneticBuscaBR, true},
+		{"buscabr different", "Thiago", "Diogo", PhoneticBuscaBR, false},
+		{"cologne", "Meyer", "Maier", PhoneticCologne, true},
+		{"nysiis", "Knuth", "Nuth", PhoneticNYSIIS, true},
+		{"refined soundex", "Braz", "Broz", PhoneticRefinedSoundex, true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := NamesSoundAlike(tc.a, tc.b, tc.algo)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q, %q,\n\tExpected: %v, \n\tGot: %v", tc.a, tc.b, tc.expectedOutput, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package stringo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	// pipelineStepSeparator separates steps on pipeline specs. I.E: "trim|onlydigits|hash"
+	pipelineStepSeparator = "|"
+	// pipelineArgSeparator separates a step name from its argument on pipeline specs. I.E: "truncate:40"
+	pipelineArgSeparator = ":"
+)
+
+var (
+	// ErrPipelineUnknownStep means a step name, or transform flag, isn't built-in nor registered
+	ErrPipelineUnknownStep = errors.New("unknown pipeline step")
+	// ErrPipelineConflictingSteps means the steps would always produce an empty string, like "onlydigits|onlyletters"
+	ErrPipelineConflictingSteps = errors.New("conflicting pipeline steps")
+	// ErrPipelineInvalidStep means a step spec is malformed, like a missing or invalid argument
+	ErrPipelineInvalidStep = errors.New("invalid pipeline step")
+	// ErrPipelineStepExists means a step can't be registered, because its name is already taken
+	ErrPipelineStepExists = errors.New("pipeline step already exists")
+)
+
+// PipelineStepFunc is a single transformation, which can be registered as a pipeline step with RegisterPipelineStep
+type PipelineStepFunc func(string) (string, error)
+
+// PipelineTrace holds the input and output of a single step, as reported by Pipeline.Trace
+type PipelineTrace struct {
+	Step   string
+	Input  string
+	Output string
+}
+
+// pipelineStep is a resolved step, ready to run
+type pipelineStep struct {
+	spec string
+	name string
+	fn   PipelineStepFunc
+}
+
+// Pipeline is a sequence of named transformation steps, built from built-in steps and user registered ones
+// A pipeline can be written as a spec string, like "trim|onlydigits|reshape:#####-###", handy for config files.
+// Pipelines are immutable and safe for concurrent use.
+type Pipeline struct {
+	steps []pipelineStep
+}
+
+// simpleStep adapts an infallible transformation to a step factory without arguments
+func simpleStep(fn func(string) string) func(arg string) (PipelineStepFunc, error) {
+	return func(arg string) (PipelineStepFunc, error) {
+		if arg != "" {
+			return nil, fmt.Errorf("%w: unexpected argument %q", ErrPipelineInvalidStep, arg)
+		}
+
+		return func(s string) (string, error) {
+			return fn(s), nil
+		}, nil
+	}
+}
+
+// builtinPipelineSteps are the steps every pipeline knows, by name. Each factory receives the step argument, if any.
+var builtinPipelineSteps = map[string]func(arg string) (PipelineStepFunc, error){
+	"trim":                 simpleStep(strings.TrimSpace),
+	"lower":                simpleStep(strings.ToLower),
+	"upper":                simpleStep(strings.ToUpper),
+	"title":                simpleStep(Title),
+	"onlydigits":           simpleStep(OnlyDigits),
+	"onlyletters":          simpleStep(OnlyLetters),
+	"onlylettersanddigits": simpleStep(OnlyLettersAndNumbers),
+	"removedigits":         simpleStep(RemoveDigits),
+	"dedupspaces":          simpleStep(DedupSpaces),
+	"hash":                 simpleStep(Sha256Hash),
+	"reverse":              simpleStep(Reverse),
+	"removeaccents":        simpleStep(RemoveAccents),
+	"casefold":             simpleStep(CaseFold),
+	"camel":                simpleStep(ToCamel),
+	"pascal":               simpleStep(ToPascal),
+	"snake":                simpleStep(ToSnake),
+	"kebab":                simpleStep(ToKebab),
+	"screamingsnake":       simpleStep(ToScreamingSnake),
+	"dot":                  simpleStep(ToDotCase),
+	"train":                simpleStep(ToTrainCase),
+	"transliterate": func(arg string) (PipelineStepFunc, error) {
+		lang := language.Und
+
+		if arg != "" {
+			var err error
+
+			if lang, err = language.Parse(arg); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrPipelineInvalidStep, err)
+			}
+		}
+
+		return func(s string) (string, error) {
+			return Transliterate(s, lang), nil
+		}, nil
+	},
+	"truncate": func(arg string) (PipelineStepFunc, error) {
+		maxLen, err := strconv.Atoi(arg)
+		if err != nil || maxLen < 1 {
+			return nil, fmt.Errorf("%w: truncate requires a positive length, got %q", ErrPipelineInvalidStep, arg)
+		}
+
+		return func(s string) (string, error) {
+			if utf8.RuneCountInString(s) > maxLen {
+				s = string([]rune(s)[:maxLen])
+			}
+
+			return s, nil
+		}, nil
+	},
+	"reshape": func(arg string) (PipelineStepFunc, error) {
+		if arg == "" {
+			return nil, fmt.Errorf("%w: reshape requires a format", ErrPipelineInvalidStep)
+		}
+
+		return func(s string) (string, error) {
+			return Reshape(arg, s), nil
+		}, nil
+	},
+}
+
+// pipelineFlagSteps maps transform flags to their pipeline step names
+var pipelineFlagSteps = map[TransformFlag]string{
+	TransformTrim:                 "trim",
+	TransformLowerCase:            "lower",
+	TransformUpperCase:            "upper",
+	TransformOnlyDigits:           "onlydigits",
+	TransformOnlyLetters:          "onlyletters",
+	TransformOnlyLettersAndDigits: "onlylettersanddigits",
+	TransformHash:                 "hash",
+	TransformTitleCase:            "title",
+	TransformRemoveDigits:         "removedigits",
+	TransformRemoveAccents:        "removeaccents",
+	TransformTransliterate:        "transliterate",
+	TransformCaseFold:             "casefold",
+	TransformCamelCase:            "camel",
+	TransformPascalCase:           "pascal",
+	TransformSnakeCase:            "snake",
+	TransformKebabCase:            "kebab",
+	TransformScreamingSnakeCase:   "screamingsnake",
+	TransformDotCase:              "dot",
+	TransformTrainCase:            "train",
+}
+
+// pipelineConflicts lists the built-in steps which, combined, always produce an empty string
+var pipelineConflicts = map[string][]string{
+	"onlydigits":   {"onlyletters", "removedigits"},
+	"onlyletters":  {"onlydigits"},
+	"removedigits": {"onlydigits"},
+}
+
+// pipelineRenewers are the built-in steps that generate new content, so previous filters can't conflict with the following ones
+var pipelineRenewers = wordSet("hash", "reshape")
+
+var (
+	customPipelineStepsMutex sync.RWMutex
+	customPipelineSteps      = map[string]PipelineStepFunc{}
+)
+
+// RegisterPipelineStep makes a custom step available to pipelines, under the given name
+// Names are case-insensitive, and can't contain spaces, "|" nor ":". Built-in names can't be overridden.
+// Example: RegisterPipelineStep("nobom", func(s string) (string, error) { return strings.TrimPrefix(s, "\uFEFF"), nil })
+func RegisterPipelineStep(name string, fn PipelineStepFunc) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if name == "" || fn == nil || strings.ContainsAny(name, " \t\n\r"+pipelineStepSeparator+pipelineArgSeparator) {
+		return fmt.Errorf("%w: can't register %q", ErrPipelineInvalidStep, name)
+	}
+
+	if _, ok := builtinPipelineSteps[name]; ok {
+		return fmt.Errorf("%w: %q", ErrPipelineStepExists, name)
+	}
+
+	customPipelineStepsMutex.Lock()
+	defer customPipelineStepsMutex.Unlock()
+
+	if _, ok := customPipelineSteps[name]; ok {
+		return fmt.Errorf("%w: %q", ErrPipelineStepExists, name)
+	}
+
+	customPipelineSteps[name] = fn
+
+	return nil
+}
+
+// resolvePipelineStep turns a step spec, like "truncate:40", into a runnable step
+func resolvePipelineStep(spec string) (pipelineStep, error) {
+	spec = strings.TrimSpace(spec)
+
+	name, arg := spec, ""
+
+	if i := strings.Index(spec, pipelineArgSeparator); i >= 0 {
+		name, arg = spec[:i], spec[i+len(pipelineArgSeparator):]
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if name == "" {
+		return pipelineStep{}, fmt.Errorf("%w: empty step", ErrPipelineInvalidStep)
+	}
+
+	if factory, ok := builtinPipelineSteps[name]; ok {
+		fn, err := factory(arg)
+		if err != nil {
+			return pipelineStep{}, fmt.Errorf("step %q: %w", spec, err)
+		}
+
+		return pipelineStep{spec: spec, name: name, fn: fn}, nil
+	}
+
+	customPipelineStepsMutex.RLock()
+	fn, ok := customPipelineSteps[name]
+	customPipelineStepsMutex.RUnlock()
+
+	if !ok {
+		return pipelineStep{}, fmt.Errorf("%w: %q", ErrPipelineUnknownStep, name)
+	}
+
+	if arg != "" {
+		return pipelineStep{}, fmt.Errorf("%w: custom step %q doesn't accept arguments", ErrPipelineInvalidStep, name)
+	}
+
+	return pipelineStep{spec: spec, name: name, fn: fn}, nil
+}
+
+// NewPipeline builds a pipeline from the given step specs, in order
+// Unknown steps, malformed arguments and conflicting steps are reported here, rather than when the pipeline runs.
+// Example: NewPipeline("trim", "onlydigits", "reshape:#####-###")
+func NewPipeline(steps ...string) (*Pipeline, error) {
+	p := &Pipeline{}
+
+	// filters holds the built-in filters applied since the last step that could generate new content
+	filters := map[string]bool{}
+
+	for _, spec := range steps {
+		step, err := resolvePipelineStep(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, other := range pipelineConflicts[step.name] {
+			if filters[other] {
+				return nil, fmt.Errorf("%w: %q and %q", ErrPipelineConflictingSteps, other, step.name)
+			}
+		}
+
+		if _, builtin := builtinPipelineSteps[step.name]; !builtin || pipelineRenewers[step.name] {
+			filters = map[string]bool{}
+		} else {
+			filters[step.name] = true
+		}
+
+		p.steps = append(p.steps, step)
+	}
+
+	return p, nil
+}
+
+// ParsePipeline builds a pipeline from a spec string, with steps separated by "|"
+// Example: ParsePipeline("trim|onlydigits|hash")
+func ParsePipeline(spec string) (*Pipeline, error) {
+	if strings.TrimSpace(spec) == "" {
+		return &Pipeline{}, nil
+	}
+
+	return NewPipeline(strings.Split(spec, pipelineStepSeparator)...)
+}
+
+// PipelineFromFlags builds a pipeline from transform flags, applied in the given order, as TransformSerially does
+// Unlike TransformSerially, unknown flags are reported. TransformNone is accepted, and does nothing.
+func PipelineFromFlags(flags ...TransformFlag) (*Pipeline, error) {
+	var steps []string
+
+	for _, f := range flags {
+		if f == TransformNone {
+			continue
+		}
+
+		name, ok := pipelineFlagSteps[f]
+		if !ok {
+			return nil, fmt.Errorf("%w: transform flag %d", ErrPipelineUnknownStep, f)
+		}
+
+		steps = append(steps, name)
+	}
+
+	return NewPipeline(steps...)
+}
+
+// String returns the pipeline spec, which ParsePipeline accepts
+func (p *Pipeline) String() string {
+	specs := make([]string, len(p.steps))
+
+	for i, step := range p.steps {
+		specs[i] = step.spec
+	}
+
+	return strings.Join(specs, pipelineStepSeparator)
+}
+
+// MarshalText implements encoding.TextMarshaler, so pipelines can be written to config files as spec strings
+func (p *Pipeline) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, so pipelines can be read from config files as spec strings
+func (p *Pipeline) UnmarshalText(text []byte) error {
+	parsed, err := ParsePipeline(string(text))
+	if err != nil {
+		return err
+	}
+
+	p.steps = parsed.steps
+
+	return nil
+}
+
+// Run applies all the pipeline steps to the given string, in order
+// It stops at the first failing step, returning its error
+func (p *Pipeline) Run(s string) (string, error) {
+	for _, step := range p.steps {
+		var err error
+
+		if s, err = step.fn(s); err != nil {
+			return "", fmt.Errorf("pipeline step %q: %w", step.spec, err)
+		}
+	}
+
+	return s, nil
+}
+
+// Trace works like Run, but also reports the input and output of every step, for debugging purposes
+// If a step fails, the trace holds the steps that ran before it.
+func (p *Pipeline) Trace(s string) ([]PipelineTrace, error) {
+	trace := make([]PipelineTrace, 0, len(p.steps))
+
+	for _, step := range p.steps {
+		out, err := step.fn(s)
+		if err != nil {
+			return trace, fmt.Errorf("pipeline step %q: %w", step.spec, err)
+		}
+
+		trace = append(trace, PipelineTrace{Step: step.spec, Input: s, Output: out})
+		s = out
+	}
+
+	return trace, nil
+}
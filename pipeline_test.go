@@ -0,0 +1,142 @@
+package stringo
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPipeline(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		spec           string
+		input          string
+		expectedOutput string
+		expectedErr    error
+	}{
+		{"empty spec", "", " As Is ", " As Is ", nil},
+		{"trim and upper", "trim|upper", "  hello ", "HELLO", nil},
+		{"spaces around steps", " trim | upper ", "  hello ", "HELLO", nil},
+		{"zipcode", "onlydigits|reshape:#####-###", "CEP 90.010-000", "90010-000", nil},
+		{"truncate", "dedupspaces|truncate:8", "São   Paulo city", "São Paul", nil},
+		{"transliterate with language", "transliterate:de|snake", "Müller Straße", "mueller_strasse", nil},
+		{"unknown step", "trim|shout", "", "", ErrPipelineUnknownStep},
+		{"conflicting filters", "onlydigits|trim|onlyletters", "", "", ErrPipelineConflictingSteps},
+		{"hash renews content", "onlydigits|hash|removedigits", "1", "", nil},
+		{"invalid truncate", "truncate:zero", "", "", ErrPipelineInvalidStep},
+		{"unexpected argument", "trim:1", "", "", ErrPipelineInvalidStep},
+		{"empty step", "trim||upper", "", "", ErrPipelineInvalidStep},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			p, err := ParsePipeline(tc.spec)
+
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("Test has failed!\n\tSpec: %s,\n\tExpected error: %v, \n\tGot: %v", tc.spec, tc.expectedErr, err)
+			}
+
+			if err != nil || tc.expectedOutput == "" {
+				return
+			}
+
+			r, err := p.Run(tc.input)
+
+			if err != nil || r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tSpec: %s,\n\tExpected: %s, \n\tGot: %s, %v", tc.spec, tc.expectedOutput, r, err)
+			}
+		})
+	}
+}
+
+func TestPipelineCustomStep(t *testing.T) {
+	errNotANumber := errors.New("not a number")
+
+	err := RegisterPipelineStep("RequireDigits", func(s string) (string, error) {
+		if !HasOnlyDigits(s) {
+			return "", errNotANumber
+		}
+
+		return s, nil
+	})
+	if err != nil {
+		t.Fatalf("Can't register step: %v", err)
+	}
+
+	if err = RegisterPipelineStep("requiredigits", func(s string) (string, error) { return strings.TrimSpace(s), nil }); !errors.Is(err, ErrPipelineStepExists) {
+		t.Errorf("Duplicated registration: expected %v, got %v", ErrPipelineStepExists, err)
+	}
+
+	if err = RegisterPipelineStep("trim", nil); !errors.Is(err, ErrPipelineInvalidStep) {
+		t.Errorf("Nil function: expected %v, got %v", ErrPipelineInvalidStep, err)
+	}
+
+	if err = RegisterPipelineStep("upper", func(s string) (string, error) { return s, nil }); !errors.Is(err, ErrPipelineStepExists) {
+		t.Errorf("Built-in override: expected %v, got %v", ErrPipelineStepExists, err)
+	}
+
+	p, err := NewPipeline("trim", "requiredigits", "hash")
+	if err != nil {
+		t.Fatalf("Can't build pipeline: %v", err)
+	}
+
+	if _, err = p.Run(" 12a "); !errors.Is(err, errNotANumber) {
+		t.Errorf("Failing step: expected %v, got %v", errNotANumber, err)
+	}
+
+	trace, err := p.Trace(" 123 ")
+	if err != nil || len(trace) != 3 {
+		t.Fatalf("Trace has failed: %v %v", trace, err)
+	}
+
+	if trace[0].Input != " 123 " || trace[0].Output != "123" || trace[1].Step != "requiredigits" || trace[2].Output != Sha256Hash("123") {
+		t.Errorf("Unexpected trace: %+v", trace)
+	}
+
+	if trace, err = p.Trace("x"); err == nil || len(trace) != 1 {
+		t.Errorf("Trace should stop at the failing step: %+v %v", trace, err)
+	}
+}
+
+func TestPipelineSerialization(t *testing.T) {
+	var config struct {
+		Phone *Pipeline `json:"phone"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"phone":"onlydigits|reshape:(##) #####-####"}`), &config); err != nil {
+		t.Fatalf("Can't unmarshal: %v", err)
+	}
+
+	if r, _ := config.Phone.Run("51 99999 8888"); r != "(51) 99999-8888" {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s", "(51) 99999-8888", r)
+	}
+
+	b, err := json.Marshal(config)
+	if err != nil || string(b) != `{"phone":"onlydigits|reshape:(##) #####-####"}` {
+		t.Errorf("Can't marshal: %s %v", b, err)
+	}
+
+	if err = json.Unmarshal([]byte(`{"phone":"onlydigits|nope"}`), &config); !errors.Is(err, ErrPipelineUnknownStep) {
+		t.Errorf("Expected %v, got %v", ErrPipelineUnknownStep, err)
+	}
+}
+
+func TestPipelineFromFlags(t *testing.T) {
+	p, err := PipelineFromFlags(TransformNone, TransformTrim, TransformRemoveDigits, TransformUpperCase)
+	if err != nil {
+		t.Fatalf("Can't build pipeline: %v", err)
+	}
+
+	if p.String() != "trim|removedigits|upper" {
+		t.Errorf("Unexpected spec: %s", p.String())
+	}
+
+	if r, _ := p.Run(" r2d2 "); r != TransformSerially(" r2d2 ", 0, TransformTrim, TransformRemoveDigits, TransformUpperCase) {
+		t.Errorf("Pipeline and TransformSerially diverge: %s", r)
+	}
+
+	if _, err = PipelineFromFlags(TransformTrim, TransformFlag(3)); !errors.Is(err, ErrPipelineUnknownStep) {
+		t.Errorf("Expected %v, got %v", ErrPipelineUnknownStep, err)
+	}
+}
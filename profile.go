@@ -0,0 +1,218 @@
+package stringo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrProfileNotFound means there's no normalization profile registered with the given name
+var ErrProfileNotFound = errors.New("normalization profile not found")
+
+// Profile is a named normalization recipe for a field, like a zipcode or a person name
+// Steps are pipeline step specs, as accepted by NewPipeline. MaxLen truncates the result, in runes, after all steps. 0 means no truncation.
+// In JSON, a profile is either an object, like {"steps": ["trim", "dedupspaces", "title"], "maxLen": 40},
+// or just a pipeline spec string, like "onlydigits|reshape:#####-###".
+type Profile struct {
+	Steps  []string `json:"steps"`
+	MaxLen int      `json:"maxLen,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting both the object and the spec string forms
+func (p *Profile) UnmarshalJSON(data []byte) error {
+	var spec string
+
+	if err := json.Unmarshal(data, &spec); err == nil {
+		p.Steps, p.MaxLen = nil, 0
+
+		if strings.TrimSpace(spec) != "" {
+			p.Steps = strings.Split(spec, pipelineStepSeparator)
+		}
+
+		return nil
+	}
+
+	// The alias avoids an infinite recursion on UnmarshalJSON
+	type profileObject Profile
+
+	var obj profileObject
+
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	*p = Profile(obj)
+
+	return nil
+}
+
+// compiledProfile is a profile with its pipeline already built
+type compiledProfile struct {
+	profile  Profile
+	pipeline *Pipeline
+}
+
+// compileProfile builds the profile pipeline, reporting unknown or conflicting steps
+func compileProfile(name string, p Profile) (compiledProfile, error) {
+	if p.MaxLen < 0 {
+		return compiledProfile{}, fmt.Errorf("profile %q: negative maxLen %d", name, p.MaxLen)
+	}
+
+	pipeline, err := NewPipeline(p.Steps...)
+	if err != nil {
+		return compiledProfile{}, fmt.Errorf("profile %q: %w", name, err)
+	}
+
+	return compiledProfile{profile: p, pipeline: pipeline}, nil
+}
+
+// ProfileRegistry holds normalization profiles by name. It's safe for concurrent use.
+// Most applications are fine with the package level functions, like LoadProfiles and ApplyProfile, which use a default registry.
+type ProfileRegistry struct {
+	mutex    sync.RWMutex
+	profiles map[string]compiledProfile
+}
+
+// NewProfileRegistry returns an empty registry
+func NewProfileRegistry() *ProfileRegistry {
+	return &ProfileRegistry{profiles: map[string]compiledProfile{}}
+}
+
+// defaultProfiles is the registry used by package level functions
+var defaultProfiles = NewProfileRegistry()
+
+// Register adds a profile to the registry, replacing any other with the same name
+func (r *ProfileRegistry) Register(name string, p Profile) error {
+	cp, err := compileProfile(name, p)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	r.profiles[name] = cp
+	r.mutex.Unlock()
+
+	return nil
+}
+
+// Load reads profiles from a JSON object, where each key is a profile name, and adds them to the registry
+// If any profile is invalid, none of them is added.
+// Example: {"zipcode": "onlydigits|reshape:#####-###", "name": {"steps": ["trim", "dedupspaces", "title"], "maxLen": 40}}
+func (r *ProfileRegistry) Load(rd io.Reader) error {
+	var profiles map[string]Profile
+
+	if err := json.NewDecoder(rd).Decode(&profiles); err != nil {
+		return fmt.Errorf("can't decode profiles: %w", err)
+	}
+
+	compiled := make(map[string]compiledProfile, len(profiles))
+
+	for name, p := range profiles {
+		cp, err := compileProfile(name, p)
+		if err != nil {
+			return err
+		}
+
+		compiled[name] = cp
+	}
+
+	r.mutex.Lock()
+
+	for name, cp := range compiled {
+		r.profiles[name] = cp
+	}
+
+	r.mutex.Unlock()
+
+	return nil
+}
+
+// Profile returns the profile registered with the given name, if any
+func (r *ProfileRegistry) Profile(name string) (Profile, bool) {
+	r.mutex.RLock()
+	cp, ok := r.profiles[name]
+	r.mutex.RUnlock()
+
+	return cp.profile, ok
+}
+
+// Apply normalizes the given string according the named profile
+func (r *ProfileRegistry) Apply(name, s string) (string, error) {
+	r.mutex.RLock()
+	cp, ok := r.profiles[name]
+	r.mutex.RUnlock()
+
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrProfileNotFound, name)
+	}
+
+	s, err := cp.pipeline.Run(s)
+	if err != nil {
+		return "", fmt.Errorf("profile %q: %w", name, err)
+	}
+
+	if cp.profile.MaxLen > 0 && utf8.RuneCountInString(s) > cp.profile.MaxLen {
+		s = string([]rune(s)[:cp.profile.MaxLen])
+	}
+
+	return s, nil
+}
+
+// ApplyToMap normalizes a record, like a CSV row, where keys are field names and values are field contents
+// Each field is normalized by the profile with the same name. Fields without profile are copied as they are.
+// It returns a new map, leaving the given one untouched. If any field fails, the first error is returned along with the map.
+func (r *ProfileRegistry) ApplyToMap(m map[string]string) (map[string]string, error) {
+	var (
+		result   = make(map[string]string, len(m))
+		firstErr error
+	)
+
+	for field, value := range m {
+		if _, ok := r.Profile(field); !ok {
+			result[field] = value
+			continue
+		}
+
+		normalized, err := r.Apply(field, value)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("field %q: %w", field, err)
+			}
+
+			result[field] = value
+
+			continue
+		}
+
+		result[field] = normalized
+	}
+
+	return result, firstErr
+}
+
+// RegisterProfile adds a profile to the default registry, replacing any other with the same name
+// Example: RegisterProfile("zipcode", Profile{Steps: []string{"onlydigits", "reshape:#####-###"}})
+func RegisterProfile(name string, p Profile) error {
+	return defaultProfiles.Register(name, p)
+}
+
+// LoadProfiles reads profiles from a JSON object into the default registry. See ProfileRegistry.Load
+func LoadProfiles(rd io.Reader) error {
+	return defaultProfiles.Load(rd)
+}
+
+// ApplyProfile normalizes the given string according the named profile, from the default registry
+// Example: ApplyProfile("zipcode", "CEP 90010000") returns "90010-000", if profile "zipcode" is "onlydigits|reshape:#####-###"
+func ApplyProfile(name, s string) (string, error) {
+	return defaultProfiles.Apply(name, s)
+}
+
+// ApplyProfilesToMap normalizes every field of a record by the profile with the same name, from the default registry
+// See ProfileRegistry.ApplyToMap
+func ApplyProfilesToMap(m map[string]string) (map[string]string, error) {
+	return defaultProfiles.ApplyToMap(m)
+}
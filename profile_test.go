@@ -0,0 +1,93 @@
+package stringo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const profilesConfig = `{
+	"zipcode": "onlydigits|reshape:#####-###",
+	"name": {"steps": ["trim", "dedupspaces", "title"], "maxLen": 20},
+	"state": {"steps": ["trim", "onlyletters", "upper"], "maxLen": 2}
+}`
+
+func TestProfiles(t *testing.T) {
+	r := NewProfileRegistry()
+
+	if err := r.Load(strings.NewReader(profilesConfig)); err != nil {
+		t.Fatalf("Can't load profiles: %v", err)
+	}
+
+	tcs := []struct {
+		summary        string
+		profile        string
+		input          string
+		expectedOutput string
+		expectedErr    error
+	}{
+		{"zipcode", "zipcode", "CEP 90010000", "90010-000", nil},
+		{"name", "name", "  friedrich   wilhelm\tNIETZSCHE ", "Friedrich Wilhelm Ni", nil},
+		{"state", "state", " r.s. ", "RS", nil},
+		{"unknown profile", "phone", "123", "", ErrProfileNotFound},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			s, err := r.Apply(tc.profile, tc.input)
+
+			if !errors.Is(err, tc.expectedErr) || s != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %s,\n\tExpected: %s (%v), \n\tGot: %s (%v)", tc.input, tc.expectedOutput, tc.expectedErr, s, err)
+			}
+		})
+	}
+
+	row := map[string]string{"zipcode": "90010000", "state": "rs", "notes": "  kept as is "}
+
+	normalized, err := r.ApplyToMap(row)
+	if err != nil {
+		t.Fatalf("Can't apply profiles to map: %v", err)
+	}
+
+	if normalized["zipcode"] != "90010-000" || normalized["state"] != "RS" || normalized["notes"] != "  kept as is " || row["state"] != "rs" {
+		t.Errorf("Unexpected map: %v, original: %v", normalized, row)
+	}
+}
+
+func TestProfilesInvalidConfig(t *testing.T) {
+	r := NewProfileRegistry()
+
+	if err := r.Load(strings.NewReader(`{"ok": "trim", "bad": "trim|shout"}`)); !errors.Is(err, ErrPipelineUnknownStep) {
+		t.Errorf("Expected %v, got %v", ErrPipelineUnknownStep, err)
+	}
+
+	if _, ok := r.Profile("ok"); ok {
+		t.Errorf("No profile should be loaded from an invalid config")
+	}
+
+	if err := r.Load(strings.NewReader(`["trim"]`)); err == nil {
+		t.Errorf("Expected decoding error")
+	}
+}
+
+func TestDefaultProfiles(t *testing.T) {
+	if err := RegisterProfile("test-cpf", Profile{Steps: []string{"onlydigits", "reshape:###.###.###-##"}}); err != nil {
+		t.Fatalf("Can't register profile: %v", err)
+	}
+
+	if s, err := ApplyProfile("test-cpf", "123 456 789 01"); err != nil || s != "123.456.789-01" {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s (%v)", "123.456.789-01", s, err)
+	}
+
+	if m, err := ApplyProfilesToMap(map[string]string{"test-cpf": "12345678901"}); err != nil || m["test-cpf"] != "123.456.789-01" {
+		t.Errorf("Test has failed!\n\tGot: %v (%v)", m, err)
+	}
+
+	if err := LoadProfiles(strings.NewReader(`{"test-upper": "upper"}`)); err != nil {
+		t.Fatalf("Can't load profiles: %v", err)
+	}
+
+	if s, _ := ApplyProfile("test-upper", "abc"); s != "ABC" {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s", "ABC", s)
+	}
+}
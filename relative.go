@@ -0,0 +1,447 @@
+package stringo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// ErrRelativeTime means a relative time expression wasn't recognized
+var ErrRelativeTime = errors.New("unrecognized relative time")
+
+// relativeUnit is a unit of time, with its names in a language
+type relativeUnit struct {
+	singular string
+	plural   string
+	short    string
+}
+
+// relativeWords are the words used to humanize times and durations in a language
+type relativeWords struct {
+	// units are milliseconds, seconds, minutes, hours, days, months and years
+	units     [7]relativeUnit
+	past      string
+	future    string
+	justNow   string
+	yesterday string
+	tomorrow  string
+	and       string
+}
+
+// relative unit indexes
+const (
+	relativeMillisecond = iota
+	relativeSecond
+	relativeMinute
+	relativeHour
+	relativeDay
+	relativeMonth
+	relativeYear
+)
+
+// relativeLocales are the relative time words by language base
+var relativeLocales = map[string]*relativeWords{
+	"en": {
+		units: [7]relativeUnit{
+			{"millisecond", "milliseconds", "ms"},
+			{"second", "seconds", "s"},
+			{"minute", "minutes", "m"},
+			{"hour", "hours", "h"},
+			{"day", "days", "d"},
+			{"month", "months", "mo"},
+			{"year", "years", "y"},
+		},
+		past:      "%s ago",
+		future:    "in %s",
+		justNow:   "just now",
+		yesterday: "yesterday",
+		tomorrow:  "tomorrow",
+		and:       "and",
+	},
+	"pt": {
+		units: [7]relativeUnit{
+			{"milissegundo", "milissegundos", "ms"},
+			{"segundo", "segundos", "s"},
+			{"minuto", "minutos", "min"},
+			{"hora", "horas", "h"},
+			{"dia", "dias", "d"},
+			{"mês", "meses", "m"},
+			{"ano", "anos", "a"},
+		},
+		past:      "há %s",
+		future:    "em %s",
+		justNow:   "agora mesmo",
+		yesterday: "ontem",
+		tomorrow:  "amanhã",
+		and:       "e",
+	},
+}
+
+// relativeWordsFor returns the words for the language, falling back to English
+func relativeWordsFor(lang language.Tag) *relativeWords {
+	base, _ := lang.Base()
+
+	if w, ok := relativeLocales[base.String()]; ok {
+		return w
+	}
+
+	return relativeLocales["en"]
+}
+
+// count returns n and the singular or plural unit name
+func (w *relativeWords) count(n int64, unit int) string {
+	if n == 1 || n == -1 {
+		return fmt.Sprintf("%d %s", n, w.units[unit].singular)
+	}
+
+	return fmt.Sprintf("%d %s", n, w.units[unit].plural)
+}
+
+// Humanize describes the time relative to now, like "3 minutes ago", "in 2 days" or "yesterday", in English or Portuguese
+// Seconds count as "just now" up to 45, minutes up to 45, hours up to 22, days up to 26 and months up to 11.
+// The previous and next calendar days, in now's location, are "yesterday" and "tomorrow". Other languages fall back to English.
+// Example: Humanize(now.Add(-3*time.Minute), now, language.English) returns "3 minutes ago"
+// Example: Humanize(now.Add(2*time.Hour), now, language.BrazilianPortuguese) returns "em 2 horas"
+func Humanize(t, now time.Time, lang language.Tag) string {
+	w := relativeWordsFor(lang)
+	d := t.Sub(now)
+	abs := d
+
+	if abs < 0 {
+		abs = -abs
+	}
+
+	y1, m1, d1 := now.Date()
+	y2, m2, d2 := t.In(now.Location()).Date()
+	days := int(math.Round(time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Sub(time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)).Hours() / 24))
+
+	var s string
+
+	switch {
+	case abs < 45*time.Second:
+		return w.justNow
+	case abs < 45*time.Minute:
+		s = w.count(int64(math.Round(abs.Minutes())), relativeMinute)
+	// on the same calendar day, up to 24 hours apart, count hours rather than "0 days"
+	case abs < 22*time.Hour || days == 0:
+		s = w.count(int64(math.Round(abs.Hours())), relativeHour)
+	case days == -1:
+		return w.yesterday
+	case days == 1:
+		return w.tomorrow
+	case abs < 26*24*time.Hour:
+		if days < 0 {
+			days = -days
+		}
+
+		s = w.count(int64(days), relativeDay)
+	case abs < 320*24*time.Hour:
+		s = w.count(int64(math.Max(1, math.Round(abs.Hours()/24/30.44))), relativeMonth)
+	default:
+		s = w.count(int64(math.Max(1, math.Round(abs.Hours()/24/365.25))), relativeYear)
+	}
+
+	if d < 0 {
+		return fmt.Sprintf(w.past, s)
+	}
+
+	return fmt.Sprintf(w.future, s)
+}
+
+// DurationOptions parametrizes FormatDuration. The zero value formats every unit, abbreviated, in English.
+type DurationOptions struct {
+	// Long spells units out, as in "1 hour and 5 minutes", instead of "1h 5m"
+	Long bool
+	// Precision is how many units to consider, from the largest non-zero one. Zero means all, down to seconds.
+	// I.E: 1h0m5s is "1h" with precision 2, since minutes are the second unit, and "1h 5s" with precision 3
+	Precision int
+	// Language of unit names, English or Portuguese. Default is English
+	Language language.Tag
+}
+
+// FormatDuration formats a duration in days, hours, minutes and seconds, like "1h 5m" or "1 hour and 5 minutes"
+// Units below the precision are truncated. Durations under a second are formatted in milliseconds.
+// Example: FormatDuration(65*time.Minute, DurationOptions{}) returns "1h 5m"
+// Example: FormatDuration(65*time.Minute, DurationOptions{Long: true, Language: language.Portuguese}) returns "1 hora e 5 minutos"
+func FormatDuration(d time.Duration, opts DurationOptions) string {
+	w := relativeWordsFor(opts.Language)
+	sign := ""
+
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	type amount struct {
+		value int64
+		unit  int
+	}
+
+	var amounts []amount
+
+	if d < time.Second {
+		amounts = []amount{{d.Milliseconds(), relativeMillisecond}}
+	} else {
+		values := []amount{
+			{int64(d / (24 * time.Hour)), relativeDay},
+			{int64(d % (24 * time.Hour) / time.Hour), relativeHour},
+			{int64(d % time.Hour / time.Minute), relativeMinute},
+			{int64(d % time.Minute / time.Second), relativeSecond},
+		}
+
+		first := 0
+
+		for values[first].value == 0 {
+			first++
+		}
+
+		last := len(values)
+
+		if opts.Precision > 0 && first+opts.Precision < last {
+			last = first + opts.Precision
+		}
+
+		for _, a := range values[first:last] {
+			if a.value != 0 {
+				amounts = append(amounts, a)
+			}
+		}
+	}
+
+	words := make([]string, len(amounts))
+
+	for i, a := range amounts {
+		if opts.Long {
+			words[i] = w.count(a.value, a.unit)
+		} else {
+			words[i] = strconv.FormatInt(a.value, 10) + w.units[a.unit].short
+		}
+	}
+
+	if !opts.Long || len(words) == 1 {
+		return sign + strings.Join(words, " ")
+	}
+
+	return sign + strings.Join(words[:len(words)-1], ", ") + " " + w.and + " " + words[len(words)-1]
+}
+
+// relativeUnitWords maps English and Portuguese unit words, without accents, to durations or calendar units
+// Months and years are marked by negative values, as they're added by calendar, not by duration.
+var relativeUnitWords = map[string]time.Duration{
+	"second": time.Second, "seconds": time.Second, "sec": time.Second, "secs": time.Second, "s": time.Second,
+	"segundo": time.Second, "segundos": time.Second, "seg": time.Second,
+	"minute": time.Minute, "minutes": time.Minute, "min": time.Minute, "mins": time.Minute,
+	"minuto": time.Minute, "minutos": time.Minute,
+	"hour": time.Hour, "hours": time.Hour, "hr": time.Hour, "hrs": time.Hour, "h": time.Hour,
+	"hora": time.Hour, "horas": time.Hour,
+	"day": 24 * time.Hour, "days": 24 * time.Hour, "dia": 24 * time.Hour, "dias": 24 * time.Hour,
+	"week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour, "semana": 7 * 24 * time.Hour, "semanas": 7 * 24 * time.Hour,
+	"month": -1, "months": -1, "mes": -1, "meses": -1,
+	"year": -12, "years": -12, "ano": -12, "anos": -12,
+}
+
+// relativeWeekdays maps English and Portuguese weekday names, without accents, to weekdays
+var relativeWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday, "domingo": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday, "segunda": time.Monday, "segunda-feira": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "terca": time.Tuesday, "terca-feira": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday, "quarta": time.Wednesday, "quarta-feira": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "quinta": time.Thursday, "quinta-feira": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday, "sexta": time.Friday, "sexta-feira": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday, "sabado": time.Saturday,
+}
+
+// relativeDays maps English and Portuguese day words, without accents, to day offsets from today
+var relativeDays = map[string]int{
+	"today": 0, "hoje": 0,
+	"tomorrow": 1, "amanha": 1, "day after tomorrow": 2, "depois de amanha": 2,
+	"yesterday": -1, "ontem": -1, "day before yesterday": -2, "anteontem": -2,
+}
+
+// relativeAdd adds n units to the time, by calendar for months and years
+func relativeAdd(t time.Time, n int, unit time.Duration) time.Time {
+	if unit < 0 {
+		return t.AddDate(0, n*int(-unit), 0)
+	}
+
+	return t.Add(time.Duration(n) * unit)
+}
+
+// relativeCount parses a count, in digits or as an article, like "a" or "uma"
+func relativeCount(s string) (int, bool) {
+	switch s {
+	case "a", "an", "one", "um", "uma":
+		return 1, true
+	}
+
+	n, err := strconv.Atoi(s)
+
+	return n, err == nil && n >= 0
+}
+
+// relativeClock parses a time of day like "10am", "10:30", "22h15" or "10 pm", returning hours and minutes
+func relativeClock(s string) (int, int, bool) {
+	s = strings.ReplaceAll(s, " ", "")
+	pm, am := strings.HasSuffix(s, "pm"), strings.HasSuffix(s, "am")
+
+	if pm || am {
+		s = s[:len(s)-2]
+	}
+
+	s = strings.TrimSuffix(s, "h")
+	sep := strings.IndexAny(s, ":h")
+	hours, minutes := s, "0"
+
+	if sep >= 0 {
+		hours, minutes = s[:sep], s[sep+1:]
+	}
+
+	h, err1 := strconv.Atoi(hours)
+	m, err2 := strconv.Atoi(minutes)
+
+	if err1 != nil || err2 != nil || h < 0 || h > 23 || m < 0 || m > 59 || (pm || am) && (h < 1 || h > 12) {
+		return 0, 0, false
+	}
+
+	if pm || am {
+		h %= 12
+
+		if pm {
+			h += 12
+		}
+	}
+
+	return h, m, true
+}
+
+// relativeDate parses the date part of a relative expression, without the time of day
+// It returns the time and whether it's a whole day, whose time of day may be given apart.
+func relativeDate(s string, now time.Time) (time.Time, bool, bool) {
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+
+	if s == "now" || s == "agora" {
+		return now, false, true
+	}
+
+	if offset, ok := relativeDays[s]; ok {
+		return today.AddDate(0, 0, offset), true, true
+	}
+
+	words := strings.Fields(s)
+
+	// N units ago, N units from now, in N units, há N units, N units atrás, em N units, daqui a N units
+	past, future := false, false
+
+	switch {
+	case len(words) == 3 && (words[2] == "ago" || words[2] == "atras"):
+		past, words = true, words[:2]
+	case len(words) == 3 && words[0] == "ha":
+		past, words = true, words[1:]
+	case len(words) == 3 && (words[0] == "in" || words[0] == "em"):
+		future, words = true, words[1:]
+	case len(words) == 4 && words[2] == "from" && words[3] == "now":
+		future, words = true, words[:2]
+	case len(words) == 4 && words[0] == "daqui" && words[1] == "a":
+		future, words = true, words[2:]
+	}
+
+	if past || future {
+		n, ok := relativeCount(words[0])
+		unit, known := relativeUnitWords[words[1]]
+
+		if !ok || !known {
+			return time.Time{}, false, false
+		}
+
+		if past {
+			n = -n
+		}
+
+		return relativeAdd(now, n, unit), false, true
+	}
+
+	// next, last and bare weekdays; next week, mês que vem, ano passado
+	direction, name := 0, s
+
+	switch {
+	case len(words) == 2 && (words[0] == "next" || words[0] == "proxima" || words[0] == "proximo"):
+		direction, name = 1, words[1]
+	case len(words) == 2 && (words[0] == "last" || words[0] == "ultima" || words[0] == "ultimo"):
+		direction, name = -1, words[1]
+	case len(words) == 2 && (words[1] == "passada" || words[1] == "passado"):
+		direction, name = -1, words[0]
+	case len(words) == 3 && words[1] == "que" && words[2] == "vem":
+		direction, name = 1, words[0]
+	}
+
+	if wd, ok := relativeWeekdays[name]; ok {
+		days := (int(wd) - int(today.Weekday()) + 7) % 7
+
+		switch {
+		case direction > 0 && days == 0:
+			days = 7
+		case direction < 0:
+			days -= 7
+
+			if days == 0 {
+				days = -7
+			}
+		}
+
+		return today.AddDate(0, 0, days), true, true
+	}
+
+	if unit, ok := relativeUnitWords[name]; ok && direction != 0 && (unit < 0 || unit == 7*24*time.Hour) {
+		return relativeAdd(now, direction, unit), false, true
+	}
+
+	return time.Time{}, false, false
+}
+
+// ParseRelative parses a relative time expression, in English or Portuguese, returning the time it refers to from now
+// It understands "now", "today", "tomorrow", "yesterday", "3 days ago", "in 2 hours", "há 2 horas", "daqui a 1 semana",
+// weekdays, as in "friday", "next friday", "last friday", "sexta que vem" and "sexta passada", and "next month" or "ano passado",
+// optionally followed by a time of day, as in "at 10am", "at 14:30" or "às 10h". Days without a time of day are at midnight.
+// Example: ParseRelative("next friday at 10am", now) returns the first Friday after today, at 10:00, in now's location
+func ParseRelative(s string, now time.Time) (time.Time, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(RemoveAccents(s))), " ")
+	datePart, clock := normalized, ""
+
+	for _, sep := range []string{" at ", " as ", " @ "} {
+		if i := strings.LastIndex(normalized, sep); i >= 0 {
+			datePart, clock = normalized[:i], normalized[i+len(sep):]
+			break
+		}
+	}
+
+	t, wholeDay, ok := relativeDate(datePart, now)
+
+	if !ok && clock == "" {
+		// a bare time of day, as in "10am", is today
+		if h, m, isClock := relativeClock(normalized); isClock {
+			y, mo, d := now.Date()
+			return time.Date(y, mo, d, h, m, 0, 0, now.Location()), nil
+		}
+	}
+
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrRelativeTime, s)
+	}
+
+	if clock == "" {
+		return t, nil
+	}
+
+	h, m, isClock := relativeClock(clock)
+
+	if !isClock || !wholeDay {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrRelativeTime, s)
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), h, m, 0, 0, t.Location()), nil
+}
@@ -0,0 +1,166 @@
+package stringo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestHumanize(t *testing.T) {
+	// a Saturday
+	now := time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC)
+
+	tcs := []struct {
+		summary        string
+		input          time.Time
+		lang           language.Tag
+		expectedOutput string
+	}{
+		{"just now", now.Add(-10 * time.Second), language.English, "just now"},
+		{"minutes ago", now.Add(-3 * time.Minute), language.English, "3 minutes ago"},
+		{"one minute", now.Add(time.Minute), language.English, "in 1 minute"},
+		{"hours", now.Add(2 * time.Hour), language.English, "in 2 hours"},
+		{"yesterday", now.Add(-23 * time.Hour), language.English, "yesterday"},
+		{"tomorrow", now.Add(30 * time.Hour), language.English, "tomorrow"},
+		{"days", now.AddDate(0, 0, 2), language.English, "in 2 days"},
+		{"calendar days", now.Add(-40 * time.Hour), language.English, "2 days ago"},
+		{"months", now.AddDate(0, -3, 0), language.English, "3 months ago"},
+		{"years", now.AddDate(2, 0, 0), language.English, "in 2 years"},
+		{"portuguese past", now.Add(-2 * time.Hour), language.BrazilianPortuguese, "há 2 horas"},
+		{"portuguese future", now.AddDate(0, 0, 5), language.Portuguese, "em 5 dias"},
+		{"portuguese singular", now.AddDate(-1, 0, 0), language.Portuguese, "há 1 ano"},
+		{"portuguese months", now.AddDate(0, 2, 0), language.Portuguese, "em 2 meses"},
+		{"portuguese yesterday", now.Add(-20 * time.Hour).Add(-3 * time.Hour), language.Portuguese, "ontem"},
+		{"portuguese just now", now, language.Portuguese, "agora mesmo"},
+		{"fallback", now.Add(-3 * time.Minute), language.Japanese, "3 minutes ago"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Humanize(tc.input, now, tc.lang)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %v,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestHumanizeSameDay(t *testing.T) {
+	late := time.Date(2026, 10, 17, 23, 30, 0, 0, time.UTC)
+	early := time.Date(2026, 10, 17, 0, 15, 0, 0, time.UTC)
+
+	tcs := []struct {
+		summary        string
+		input          time.Time
+		now            time.Time
+		lang           language.Tag
+		expectedOutput string
+	}{
+		{"english past", early, late, language.English, "23 hours ago"},
+		{"english future", late, early, language.English, "in 23 hours"},
+		{"portuguese past", early, late, language.Portuguese, "há 23 horas"},
+		{"portuguese future", late, early, language.Portuguese, "em 23 horas"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Humanize(tc.input, tc.now, tc.lang)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %v, %v,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.now, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          time.Duration
+		opts           DurationOptions
+		expectedOutput string
+	}{
+		{"zero", 0, DurationOptions{}, "0ms"},
+		{"milliseconds", 250 * time.Millisecond, DurationOptions{}, "250ms"},
+		{"short", 65 * time.Minute, DurationOptions{}, "1h 5m"},
+		{"all units", 26*time.Hour + 3*time.Minute + 4*time.Second, DurationOptions{}, "1d 2h 3m 4s"},
+		{"precision", 26*time.Hour + 3*time.Minute + 4*time.Second, DurationOptions{Precision: 2}, "1d 2h"},
+		{"precision skips zeros", time.Hour + 5*time.Second, DurationOptions{Precision: 2}, "1h"},
+		{"precision keeps later units", time.Hour + 5*time.Second, DurationOptions{Precision: 3}, "1h 5s"},
+		{"negative", -90 * time.Second, DurationOptions{}, "-1m 30s"},
+		{"long", 65 * time.Minute, DurationOptions{Long: true}, "1 hour and 5 minutes"},
+		{"long single", 2 * time.Hour, DurationOptions{Long: true}, "2 hours"},
+		{"long list", 26*time.Hour + 3*time.Minute + 1*time.Second, DurationOptions{Long: true}, "1 day, 2 hours, 3 minutes and 1 second"},
+		{"long portuguese", 65 * time.Minute, DurationOptions{Long: true, Language: language.Portuguese}, "1 hora e 5 minutos"},
+		{"short portuguese", 65 * time.Minute, DurationOptions{Language: language.Portuguese}, "1h 5min"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := FormatDuration(tc.input, tc.opts)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %v,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestParseRelative(t *testing.T) {
+	// a Saturday
+	now := time.Date(2026, 10, 17, 14, 25, 0, 0, time.UTC)
+
+	tcs := []struct {
+		input          string
+		expectedOutput time.Time
+	}{
+		{"now", now},
+		{"Agora", now},
+		{"today", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"tomorrow at 10am", time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)},
+		{"amanhã às 10h", time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)},
+		{"yesterday at 22:15", time.Date(2026, 10, 16, 22, 15, 0, 0, time.UTC)},
+		{"anteontem", time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"3 days ago", now.AddDate(0, 0, -3)},
+		{"in 2 hours", now.Add(2 * time.Hour)},
+		{"an hour from now", now.Add(time.Hour)},
+		{"há 2 horas", now.Add(-2 * time.Hour)},
+		{"2 semanas atrás", now.AddDate(0, 0, -14)},
+		{"daqui a 1 mês", now.AddDate(0, 1, 0)},
+		{"em 5 minutos", now.Add(5 * time.Minute)},
+		{"next friday at 10am", time.Date(2026, 10, 23, 10, 0, 0, 0, time.UTC)},
+		{"friday", time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)},
+		{"saturday", time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"next saturday", time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC)},
+		{"last saturday", time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)},
+		{"last monday at 9:30 pm", time.Date(2026, 10, 12, 21, 30, 0, 0, time.UTC)},
+		{"sexta que vem", time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)},
+		{"sexta-feira passada", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"próxima terça às 14h30", time.Date(2026, 10, 20, 14, 30, 0, 0, time.UTC)},
+		{"next week", now.AddDate(0, 0, 7)},
+		{"mês passado", now.AddDate(0, -1, 0)},
+		{"ano que vem", now.AddDate(1, 0, 0)},
+		{"10am", time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.input, func(t *testing.T) {
+			r, err := ParseRelative(tc.input, now)
+
+			if err != nil || !r.Equal(tc.expectedOutput) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v, %v", tc.input, tc.expectedOutput, r, err)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "soon", "3 parsecs ago", "next blue", "now at 10am", "tomorrow at 25:00", "friday at 13pm"} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseRelative(input, now); !errors.Is(err, ErrRelativeTime) {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %v, \n\tGot: %v", input, ErrRelativeTime, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,550 @@
+package stringo
+
+import "math"
+
+type SimilarityMetric uint8
+
+const (
+	// SimilarityLevenshtein is based on the count of insertions, deletions and substitutions between strings
+	SimilarityLevenshtein SimilarityMetric = 0
+	// SimilarityDamerauLevenshtein is like SimilarityLevenshtein, but transpositions of adjacent characters count as a single edit
+	SimilarityDamerauLevenshtein SimilarityMetric = 1
+	// SimilarityJaro is based on matching characters and transpositions, and suits short strings, like names
+	SimilarityJaro SimilarityMetric = 2
+	// SimilarityJaroWinkler is like SimilarityJaro, but favors strings with a common prefix
+	SimilarityJaroWinkler SimilarityMetric = 3
+	// SimilarityLCS is based on the longest common subsequence
+	SimilarityLCS SimilarityMetric = 4
+	// SimilarityJaccard is the Jaccard index of the n-gram sets
+	SimilarityJaccard SimilarityMetric = 5
+	// SimilarityCosine is the cosine similarity of the n-gram frequency vectors
+	SimilarityCosine SimilarityMetric = 6
+	// SimilaritySorensenDice is the Sørensen-Dice coefficient of the bigrams
+	SimilaritySorensenDice SimilarityMetric = 7
+)
+
+// SimilarityOptions parametrizes Similarity and MostSimilar. The zero value uses the Levenshtein metric, comparing runes.
+type SimilarityOptions struct {
+	Metric SimilarityMetric
+	// Graphemes compares grapheme clusters, instead of runes. I.E: "é" counts as a single character, even if decomposed
+	Graphemes bool
+	// NGram is the n-gram size for SimilarityJaccard and SimilarityCosine. Default is 2
+	NGram int
+	// Threshold is the minimum similarity for MostSimilar to accept a candidate
+	Threshold float64
+}
+
+// symbols splits the given strings into comparable units: runes, or grapheme clusters interned as runes
+func symbols(a, b string, graphemes bool) ([]rune, []rune) {
+	if !graphemes {
+		return []rune(a), []rune(b)
+	}
+
+	interned := map[string]rune{}
+
+	intern := func(s string) []rune {
+		var r []rune
+
+		for _, g := range Graphemes(s) {
+			id, ok := interned[g]
+
+			if !ok {
+				id = rune(len(interned))
+				interned[g] = id
+			}
+
+			r = append(r, id)
+		}
+
+		return r
+	}
+
+	return intern(a), intern(b)
+}
+
+// minInt returns the smallest of the given values
+func minInt(values ...int) int {
+	m := values[0]
+
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+
+	return m
+}
+
+// maxInt returns the largest of a and b
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// levenshtein computes the edit distance between a and b, giving up as soon as it exceeds limit, if limit>=0
+func levenshtein(a, b []rune, limit int) int {
+	if limit >= 0 && abs(len(a)-len(b)) > limit {
+		return limit + 1
+	}
+
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+
+			if cur[j] < rowMin {
+				rowMin = cur[j]
+			}
+		}
+
+		if limit >= 0 && rowMin > limit {
+			return limit + 1
+		}
+
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+// damerauLevenshtein computes the unrestricted Damerau-Levenshtein distance, giving up as soon as it exceeds limit, if limit>=0
+func damerauLevenshtein(a, b []rune, limit int) int {
+	if limit >= 0 && abs(len(a)-len(b)) > limit {
+		return limit + 1
+	}
+
+	var (
+		infinity = len(a) + len(b)
+		lastRow  = map[rune]int{}
+		d        = make([][]int, len(a)+2)
+	)
+
+	for i := range d {
+		d[i] = make([]int, len(b)+2)
+		d[i][0] = infinity
+
+		if i > 0 {
+			d[i][1] = i - 1
+		}
+	}
+
+	for j := 1; j < len(b)+2; j++ {
+		d[0][j] = infinity
+		d[1][j] = j - 1
+	}
+
+	for i := 1; i <= len(a); i++ {
+		lastCol := 0
+		rowMin := d[i+1][1]
+
+		for j := 1; j <= len(b); j++ {
+			k, l := lastRow[b[j-1]], lastCol
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+				lastCol = j
+			}
+
+			d[i+1][j+1] = minInt(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[k][l]+(i-k-1)+1+(j-l-1),
+			)
+
+			if d[i+1][j+1] < rowMin {
+				rowMin = d[i+1][j+1]
+			}
+		}
+
+		if limit >= 0 && rowMin > limit {
+			return limit + 1
+		}
+
+		lastRow[a[i-1]] = i
+	}
+
+	return d[len(a)+1][len(b)+1]
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+// jaro computes the Jaro similarity, between 0 and 1
+func jaro(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	window := maxInt(len(a), len(b))/2 - 1
+
+	if window < 0 {
+		window = 0
+	}
+
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	matches := 0
+
+	for i := range a {
+		from, to := maxInt(0, i-window), minInt(len(b)-1, i+window)
+
+		for j := from; j <= to; j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+
+				break
+			}
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+
+		for !matchedB[j] {
+			j++
+		}
+
+		if a[i] != b[j] {
+			transpositions++
+		}
+
+		j++
+	}
+
+	m := float64(matches)
+
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// jaroWinkler boosts the Jaro similarity of strings sharing a prefix, up to 4 characters
+func jaroWinkler(a, b []rune) float64 {
+	j := jaro(a, b)
+
+	prefix := 0
+
+	for prefix < 4 && prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	return j + float64(prefix)*0.1*(1-j)
+}
+
+// lcs returns the longest common subsequence of a and b
+func lcs(a, b []rune) []rune {
+	t := make([][]int, len(a)+1)
+
+	for i := range t {
+		t[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				t[i][j] = t[i+1][j+1] + 1
+			} else {
+				t[i][j] = maxInt(t[i+1][j], t[i][j+1])
+			}
+		}
+	}
+
+	var r []rune
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			r = append(r, a[i])
+			i++
+			j++
+		case t[i+1][j] >= t[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return r
+}
+
+// ngrams counts the n-grams of the given symbols. Sequences shorter than n are a single n-gram.
+func ngrams(s []rune, n int) map[string]int {
+	grams := map[string]int{}
+
+	if len(s) > 0 && len(s) < n {
+		grams[string(s)]++
+	}
+
+	for i := 0; i+n <= len(s); i++ {
+		grams[string(s[i:i+n])]++
+	}
+
+	return grams
+}
+
+// jaccard computes the Jaccard index of the n-gram sets
+func jaccard(a, b []rune, n int) float64 {
+	ga, gb := ngrams(a, n), ngrams(b, n)
+
+	if len(ga) == 0 && len(gb) == 0 {
+		return 1
+	}
+
+	intersection := 0
+
+	for g := range ga {
+		if gb[g] > 0 {
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(len(ga)+len(gb)-intersection)
+}
+
+// cosine computes the cosine similarity of the n-gram frequency vectors
+func cosine(a, b []rune, n int) float64 {
+	ga, gb := ngrams(a, n), ngrams(b, n)
+
+	if len(ga) == 0 && len(gb) == 0 {
+		return 1
+	}
+
+	var dot, normA, normB float64
+
+	for g, ca := range ga {
+		dot += float64(ca * gb[g])
+		normA += float64(ca * ca)
+	}
+
+	for _, cb := range gb {
+		normB += float64(cb * cb)
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// sorensenDice computes the Sørensen-Dice coefficient of the bigram multisets
+func sorensenDice(a, b []rune) float64 {
+	ga, gb := ngrams(a, 2), ngrams(b, 2)
+
+	if len(ga) == 0 && len(gb) == 0 {
+		return 1
+	}
+
+	intersection, total := 0, 0
+
+	for g, ca := range ga {
+		intersection += minInt(ca, gb[g])
+		total += ca
+	}
+
+	for _, cb := range gb {
+		total += cb
+	}
+
+	return 2 * float64(intersection) / float64(total)
+}
+
+// distanceSimilarity turns an edit distance into a similarity between 0 and 1
+func distanceSimilarity(distance, lenA, lenB int) float64 {
+	longest := maxInt(lenA, lenB)
+
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(distance)/float64(longest)
+}
+
+// Levenshtein returns the count of single rune insertions, deletions and substitutions to turn a into b
+// Example: Levenshtein("kitten", "sitting") returns 3
+func Levenshtein(a, b string) int {
+	return levenshtein([]rune(a), []rune(b), -1)
+}
+
+// LevenshteinWithin tells if the Levenshtein distance between a and b is at most maxDistance, returning the distance if so
+// It's much faster than Levenshtein for dissimilar strings, as it gives up as soon as the distance exceeds maxDistance.
+// If the distance exceeds maxDistance, it returns maxDistance+1 and false, whichever the actual distance is
+// Example: LevenshteinWithin("kitten", "sitting", 2) returns 3, false, and LevenshteinWithin("a", "abcdef", 2) returns 3, false too
+func LevenshteinWithin(a, b string, maxDistance int) (int, bool) {
+	if maxDistance < 0 {
+		return maxDistance + 1, false
+	}
+
+	// the early exits return whatever they reached, like the length difference
+	if d := levenshtein([]rune(a), []rune(b), maxDistance); d <= maxDistance {
+		return d, true
+	}
+
+	return maxDistance + 1, false
+}
+
+// DamerauLevenshtein returns the count of single rune insertions, deletions, substitutions and transpositions of adjacent runes to turn a into b
+// Example: DamerauLevenshtein("ca", "abc") returns 2, while Levenshtein returns 3
+func DamerauLevenshtein(a, b string) int {
+	return damerauLevenshtein([]rune(a), []rune(b), -1)
+}
+
+// DamerauLevenshteinWithin is like LevenshteinWithin, but counts transpositions of adjacent runes as a single edit
+func DamerauLevenshteinWithin(a, b string, maxDistance int) (int, bool) {
+	if maxDistance < 0 {
+		return maxDistance + 1, false
+	}
+
+	// the early exits return whatever they reached, like the length difference
+	if d := damerauLevenshtein([]rune(a), []rune(b), maxDistance); d <= maxDistance {
+		return d, true
+	}
+
+	return maxDistance + 1, false
+}
+
+// Jaro returns the Jaro similarity between a and b, from 0 (nothing in common) to 1 (equal)
+// Example: Jaro("MARTHA", "MARHTA") returns 0.944
+func Jaro(a, b string) float64 {
+	return jaro([]rune(a), []rune(b))
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity between a and b, from 0 (nothing in common) to 1 (equal)
+// Strings with a common prefix, up to 4 runes, are considered more similar, which suits person names.
+// Example: JaroWinkler("MARTHA", "MARHTA") returns 0.961
+func JaroWinkler(a, b string) float64 {
+	return jaroWinkler([]rune(a), []rune(b))
+}
+
+// LongestCommonSubsequence returns the longest sequence of runes found in both strings, in the same order, not necessarily adjacent
+// Example: LongestCommonSubsequence("ABCBDAB", "BDCABA") returns "BCBA"
+func LongestCommonSubsequence(a, b string) string {
+	return string(lcs([]rune(a), []rune(b)))
+}
+
+// NGramJaccard returns the Jaccard index of the sets of n runes long substrings of a and b, from 0 to 1
+// Example: NGramJaccard("night", "nacht", 2) returns 0.142 (1 bigram in common, "ht", out of 7)
+func NGramJaccard(a, b string, n int) float64 {
+	if n < 1 {
+		n = 2
+	}
+
+	return jaccard([]rune(a), []rune(b), n)
+}
+
+// NGramCosine returns the cosine similarity of the frequencies of n runes long substrings of a and b, from 0 to 1
+func NGramCosine(a, b string, n int) float64 {
+	if n < 1 {
+		n = 2
+	}
+
+	return cosine([]rune(a), []rune(b), n)
+}
+
+// SorensenDice returns the Sørensen-Dice coefficient of the bigrams of a and b, from 0 to 1
+// Example: SorensenDice("night", "nacht") returns 0.25
+func SorensenDice(a, b string) float64 {
+	return sorensenDice([]rune(a), []rune(b))
+}
+
+// Similarity returns how similar a and b are, from 0 (nothing in common) to 1 (equal), according the given metric
+// Edit distances are normalized by the length of the longest string. Strings are compared as they are, so
+// normalize them first, for instance with Transform or a Pipeline, for case and accent insensitive comparisons.
+// Example: Similarity("Thiago", "Tiago", SimilarityOptions{Metric: SimilarityJaroWinkler}) returns 0.95
+func Similarity(a, b string, opts SimilarityOptions) float64 {
+	ra, rb := symbols(a, b, opts.Graphemes)
+
+	n := opts.NGram
+
+	if n < 1 {
+		n = 2
+	}
+
+	switch opts.Metric {
+	case SimilarityDamerauLevenshtein:
+		return distanceSimilarity(damerauLevenshtein(ra, rb, -1), len(ra), len(rb))
+	case SimilarityJaro:
+		return jaro(ra, rb)
+	case SimilarityJaroWinkler:
+		return jaroWinkler(ra, rb)
+	case SimilarityLCS:
+		if len(ra)+len(rb) == 0 {
+			return 1
+		}
+
+		return 2 * float64(len(lcs(ra, rb))) / float64(len(ra)+len(rb))
+	case SimilarityJaccard:
+		return jaccard(ra, rb, n)
+	case SimilarityCosine:
+		return cosine(ra, rb, n)
+	case SimilaritySorensenDice:
+		return sorensenDice(ra, rb)
+	}
+
+	return distanceSimilarity(levenshtein(ra, rb, -1), len(ra), len(rb))
+}
+
+// MostSimilar returns the candidate most similar to s, along with its similarity, according the given options
+// Candidates below opts.Threshold are ignored. If no candidate is accepted, it returns "" and 0.
+// Ties are won by the first candidate.
+// Example: MostSimilar("Jon", []string{"John", "Joan", "Bob"}, SimilarityOptions{Metric: SimilarityJaroWinkler}) returns "John", 0.933
+func MostSimilar(s string, candidates []string, opts SimilarityOptions) (string, float64) {
+	var (
+		best      string
+		bestScore float64
+		found     bool
+	)
+
+	for _, c := range candidates {
+		score := Similarity(s, c, opts)
+
+		if score < opts.Threshold || (found && score <= bestScore) {
+			continue
+		}
+
+		best, bestScore, found = c, score, true
+
+		if score == 1 {
+			break
+		}
+	}
+
+	return best, bestScore
+}
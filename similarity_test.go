@@ -0,0 +1,132 @@
+package stringo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEditDistances(t *testing.T) {
+	tcs := []struct {
+		summary     string
+		a, b        string
+		levenshtein int
+		damerau     int
+	}{
+		{"empty", "", "", 0, 0},
+		{"one empty", "abc", "", 3, 3},
+		{"equal", "abc", "abc", 0, 0},
+		{"kitten", "kitten", "sitting", 3, 3},
+		{"transposition", "ab", "ba", 2, 1},
+		{"unrestricted transposition", "ca", "abc", 3, 2},
+		{"runes", "coração", "coracao", 2, 2},
+		{"names", "Thiago", "Tiago", 1, 1},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			if r := Levenshtein(tc.a, tc.b); r != tc.levenshtein {
+				t.Errorf("Levenshtein has failed!\n\tInput: %q, %q,\n\tExpected: %d, \n\tGot: %d", tc.a, tc.b, tc.levenshtein, r)
+			}
+
+			if r := DamerauLevenshtein(tc.a, tc.b); r != tc.damerau {
+				t.Errorf("DamerauLevenshtein has failed!\n\tInput: %q, %q,\n\tExpected: %d, \n\tGot: %d", tc.a, tc.b, tc.damerau, r)
+			}
+
+			for max := 0; max <= 4; max++ {
+				if d, ok := LevenshteinWithin(tc.a, tc.b, max); ok != (tc.levenshtein <= max) || (ok && d != tc.levenshtein) || (!ok && d != max+1) {
+					t.Errorf("LevenshteinWithin has failed!\n\tInput: %q, %q, %d,\n\tGot: %d, %v", tc.a, tc.b, max, d, ok)
+				}
+
+				if d, ok := DamerauLevenshteinWithin(tc.a, tc.b, max); ok != (tc.damerau <= max) || (ok && d != tc.damerau) || (!ok && d != max+1) {
+					t.Errorf("DamerauLevenshteinWithin has failed!\n\tInput: %q, %q, %d,\n\tGot: %d, %v", tc.a, tc.b, max, d, ok)
+				}
+			}
+		})
+	}
+}
+
+func TestLevenshteinWithinLengthDifference(t *testing.T) {
+	// the length difference alone exceeds maxDistance, so the distance isn't computed
+	if d, ok := LevenshteinWithin("a", "abcdef", 2); d != 3 || ok {
+		t.Errorf("Test has failed!\n\tInput: %q, %q, %d,\n\tExpected: %d, %v, \n\tGot: %d, %v", "a", "abcdef", 2, 3, false, d, ok)
+	}
+
+	if d, ok := DamerauLevenshteinWithin("abcdef", "a", 1); d != 2 || ok {
+		t.Errorf("Test has failed!\n\tInput: %q, %q, %d,\n\tExpected: %d, %v, \n\tGot: %d, %v", "abcdef", "a", 1, 2, false, d, ok)
+	}
+
+	if d, ok := LevenshteinWithin("a", "b", -1); d != 0 || ok {
+		t.Errorf("Test has failed!\n\tInput: %q, %q, %d,\n\tExpected: %d, %v, \n\tGot: %d, %v", "a", "b", -1, 0, false, d, ok)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		a, b           string
+		opts           SimilarityOptions
+		expectedOutput float64
+	}{
+		{"levenshtein equal", "abc", "abc", SimilarityOptions{}, 1},
+		{"levenshtein empty", "", "", SimilarityOptions{}, 1},
+		{"levenshtein", "kitten", "sitting", SimilarityOptions{}, 0.571},
+		{"levenshtein decomposed runes", "caf\u00e9", "cafe\u0301", SimilarityOptions{}, 0.6},
+		{"levenshtein decomposed graphemes", "cafe\u0301", "cafe\u0300", SimilarityOptions{Graphemes: true}, 0.75},
+		{"damerau", "ab", "ba", SimilarityOptions{Metric: SimilarityDamerauLevenshtein}, 0.5},
+		{"jaro", "MARTHA", "MARHTA", SimilarityOptions{Metric: SimilarityJaro}, 0.944},
+		{"jaro disjoint", "abc", "xyz", SimilarityOptions{Metric: SimilarityJaro}, 0},
+		{"jaro winkler", "MARTHA", "MARHTA", SimilarityOptions{Metric: SimilarityJaroWinkler}, 0.961},
+		{"jaro winkler names", "Thiago", "Tiago", SimilarityOptions{Metric: SimilarityJaroWinkler}, 0.95},
+		{"lcs", "ABCBDAB", "BDCABA", SimilarityOptions{Metric: SimilarityLCS}, 0.615},
+		{"jaccard", "night", "nacht", SimilarityOptions{Metric: SimilarityJaccard}, 0.143},
+		{"jaccard trigrams", "abcd", "abce", SimilarityOptions{Metric: SimilarityJaccard, NGram: 3}, 0.333},
+		{"cosine", "aaab", "aab", SimilarityOptions{Metric: SimilarityCosine}, 0.949},
+		{"sorensen dice", "night", "nacht", SimilarityOptions{Metric: SimilaritySorensenDice}, 0.25},
+		{"sorensen dice short", "a", "a", SimilarityOptions{Metric: SimilaritySorensenDice}, 1},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Similarity(tc.a, tc.b, tc.opts)
+
+			if math.Abs(r-tc.expectedOutput) > 0.001 {
+				t.Errorf("Test has failed!\n\tInput: %q, %q,\n\tExpected: %.3f, \n\tGot: %.3f", tc.a, tc.b, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	if r := LongestCommonSubsequence("ABCBDAB", "BDCABA"); len(r) != 4 {
+		t.Errorf("Test has failed!\n\tExpected a 4 runes subsequence, \n\tGot: %q", r)
+	}
+
+	if r := LongestCommonSubsequence("ação", "nação"); r != "ação" {
+		t.Errorf("Test has failed!\n\tExpected: %q, \n\tGot: %q", "ação", r)
+	}
+}
+
+func TestMostSimilar(t *testing.T) {
+	tcs := []struct {
+		summary       string
+		s             string
+		candidates    []string
+		opts          SimilarityOptions
+		expectedMatch string
+	}{
+		{"no candidates", "Jon", nil, SimilarityOptions{}, ""},
+		{"first wins ties", "Jon", []string{"Bob", "John", "Joan"}, SimilarityOptions{Metric: SimilarityJaroWinkler}, "John"},
+		{"exact", "Luis", []string{"Luiz", "Luis", "Lewis"}, SimilarityOptions{}, "Luis"},
+		{"threshold", "Jon", []string{"Bob", "Ann"}, SimilarityOptions{Threshold: 0.5}, ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r, _ := MostSimilar(tc.s, tc.candidates, tc.opts)
+
+			if r != tc.expectedMatch {
+				t.Errorf("Test has failed!\n\tInput: %q, %q,\n\tExpected: %q, \n\tGot: %q", tc.s, tc.candidates, tc.expectedMatch, r)
+			}
+		})
+	}
+}
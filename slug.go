@@ -0,0 +1,161 @@
+package stringo
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+)
+
+const slugSeparatorDefault = "-"
+
+// SlugOptions parametrizes Slugify. The zero value gives lowercase, hyphen separated, unlimited length slugs.
+type SlugOptions struct {
+	// Separator joins the words. Default is "-"
+	Separator string
+	// MaxLength limits the slug length, in bytes, cutting at word boundaries. 0 means there's no maximum length
+	// If the first word alone exceeds MaxLength, it's cut anyway.
+	MaxLength int
+	// Language drives transliteration and stop words. I.E: with language.German, "Müller" becomes "mueller"
+	Language language.Tag
+	// RemoveStopWords removes words like "the", "of", "de", "da", according Language.
+	// A slug is never left empty because of stop words removal.
+	RemoveStopWords bool
+	// KeepCase keeps the original letter case, instead of lowering it
+	KeepCase bool
+}
+
+// slugStopWords holds the words removed by SlugOptions.RemoveStopWords, by language
+var slugStopWords = map[string]map[string]bool{
+	"en": wordSet("a", "an", "and", "are", "as", "at", "be", "by", "for", "from", "in", "is", "it", "of", "on", "or", "that", "the", "this", "to", "with"),
+	"pt": wordSet("a", "as", "ao", "aos", "com", "da", "das", "de", "do", "dos", "e", "em", "na", "nas", "no", "nos", "o", "os", "para", "por", "um", "uma"),
+	"es": wordSet("a", "al", "con", "de", "del", "el", "en", "la", "las", "lo", "los", "para", "por", "un", "una", "y"),
+	"fr": wordSet("a", "au", "aux", "avec", "d", "de", "des", "du", "en", "et", "l", "la", "le", "les", "par", "pour", "un", "une"),
+	"de": wordSet("am", "an", "auf", "das", "dem", "den", "der", "des", "die", "ein", "eine", "im", "in", "mit", "und", "von", "zu", "zum", "zur"),
+	"it": wordSet("a", "al", "alla", "con", "da", "dal", "de", "dei", "del", "della", "di", "e", "gli", "i", "il", "in", "la", "le", "lo", "per", "un", "una"),
+}
+
+// wordSet builds a lookup set from the given words
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+
+	for _, w := range words {
+		set[w] = true
+	}
+
+	return set
+}
+
+// Slugify turns the given string into a URL friendly slug, according options
+// The string is transliterated to ASCII, and every sequence of non-alphanumeric characters becomes a single separator.
+// Unlike Truncate, MaxLength never cuts words in half, except when the first word alone is too long.
+// Example: Slugify("São Paulo: a cidade que não para!", SlugOptions{}) returns "sao-paulo-a-cidade-que-nao-para"
+// Example: Slugify("Straße der Einheit", SlugOptions{Language: language.German, RemoveStopWords: true}) returns "strasse-einheit"
+func Slugify(s string, opts SlugOptions) string {
+	if s == "" {
+		return s
+	}
+
+	separator := opts.Separator
+
+	if separator == "" {
+		separator = slugSeparatorDefault
+	}
+
+	s = Transliterate(s, opts.Language)
+
+	if !opts.KeepCase {
+		s = strings.ToLower(s)
+	}
+
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if opts.RemoveStopWords {
+		base, _ := opts.Language.Base()
+
+		if stopWords, ok := slugStopWords[base.String()]; ok {
+			var kept []string
+
+			for _, w := range words {
+				if !stopWords[strings.ToLower(w)] {
+					kept = append(kept, w)
+				}
+			}
+
+			if len(kept) > 0 {
+				words = kept
+			}
+		}
+	}
+
+	if opts.MaxLength <= 0 {
+		return strings.Join(words, separator)
+	}
+
+	var sb strings.Builder
+
+	for i, w := range words {
+		if i == 0 {
+			if len(w) > opts.MaxLength {
+				return w[:opts.MaxLength]
+			}
+
+			sb.WriteString(w)
+			continue
+		}
+
+		if sb.Len()+len(separator)+len(w) > opts.MaxLength {
+			break
+		}
+
+		sb.WriteString(separator)
+		sb.WriteString(w)
+	}
+
+	return sb.String()
+}
+
+// UniqueSlug returns the given base slug, if it's still available, or the first available of base-2, base-3, and so on.
+// The exists function tells if a slug is already taken, usually querying a database. It takes the same options given to Slugify,
+// which the former UniqueSlug(base, exists) didn't: the number is joined by the separator, so "my_post" becomes "my_post_2",
+// and, with MaxLength, the base is cut at word boundaries so it fits along with the number. A base word is cut only if it's alone,
+// and at least one byte of the base is always kept. Other options are ignored.
+// Example: UniqueSlug("sao-paulo", exists, SlugOptions{}) returns "sao-paulo-2" if "sao-paulo" exists but "sao-paulo-2" doesn't.
+func UniqueSlug(base string, exists func(string) bool, opts SlugOptions) string {
+	if !exists(base) {
+		return base
+	}
+
+	separator := opts.Separator
+
+	if separator == "" {
+		separator = slugSeparatorDefault
+	}
+
+	for i := 2; ; i++ {
+		suffix := separator + strconv.Itoa(i)
+		head := base
+
+		if limit := opts.MaxLength - len(suffix); opts.MaxLength > 0 && len(head) > limit {
+			if limit < 1 {
+				limit = 1
+			}
+
+			head = head[:limit]
+
+			// cuts at the last word boundary, unless the cut already falls on one
+			if j := strings.LastIndex(head, separator); j > 0 && !strings.HasPrefix(base[limit:], separator) {
+				head = head[:j]
+			}
+
+			head = strings.TrimSuffix(head, separator)
+		}
+
+		if candidate := head + suffix; !exists(candidate) {
+			return candidate
+		}
+	}
+}
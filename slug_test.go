@@ -0,0 +1,78 @@
+package stringo
+
+import (
+	"strconv"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestSlugify(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		opts           SlugOptions
+		expectedOutput string
+	}{
+		{"empty", "", SlugOptions{}, ""},
+		{"accents and punctuation", "São Paulo: a cidade que não para!", SlugOptions{}, "sao-paulo-a-cidade-que-nao-para"},
+		{"leading and trailing symbols", "  --Hello,   World!--  ", SlugOptions{}, "hello-world"},
+		{"custom separator", "Hello World", SlugOptions{Separator: "_"}, "hello_world"},
+		{"keep case", "Hello World", SlugOptions{KeepCase: true}, "Hello-World"},
+		{"german transliteration and stop words", "Straße der Einheit", SlugOptions{Language: language.German, RemoveStopWords: true}, "strasse-einheit"},
+		{"german umlauts", "Müller & Söhne", SlugOptions{Language: language.German}, "mueller-soehne"},
+		{"portuguese stop words", "Rua dos Andradas, 1234", SlugOptions{Language: language.Portuguese, RemoveStopWords: true}, "rua-andradas-1234"},
+		{"only stop words are kept", "The Of", SlugOptions{Language: language.English, RemoveStopWords: true}, "the-of"},
+		{"max length at word boundary", "The quick brown fox jumps", SlugOptions{MaxLength: 17}, "the-quick-brown"},
+		{"max length exactly fits", "The quick brown", SlugOptions{MaxLength: 15}, "the-quick-brown"},
+		{"first word longer than max length", "Supercalifragilistic word", SlugOptions{MaxLength: 5}, "super"},
+		{"cyrillic", "Привет мир", SlugOptions{}, "privet-mir"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Slugify(tc.input, tc.opts)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %s,\n\tExpected: %s, \n\tGot: %s", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestUniqueSlug(t *testing.T) {
+	taken := map[string]bool{"sao-paulo": true, "sao-paulo-2": true, "sao-paulo-3": true, "my_post": true, "my_post_2": true,
+		"rio-de-janeiro": true, "supercalifragilistic": true}
+
+	for i := 2; i < 10; i++ {
+		taken["rio-de-"+strconv.Itoa(i)] = true
+	}
+
+	exists := func(s string) bool {
+		return taken[s]
+	}
+
+	tcs := []struct {
+		summary        string
+		input          string
+		opts           SlugOptions
+		expectedOutput string
+	}{
+		{"available", "rio", SlugOptions{}, "rio"},
+		{"taken thrice", "sao-paulo", SlugOptions{}, "sao-paulo-4"},
+		{"custom separator", "my_post", SlugOptions{Separator: "_"}, "my_post_3"},
+		{"max length", "rio-de-janeiro", SlugOptions{MaxLength: 10}, "rio-de-10"},
+		{"max length single word", "supercalifragilistic", SlugOptions{MaxLength: 10}, "supercal-2"},
+		{"max length not reached", "sao-paulo", SlugOptions{MaxLength: 20}, "sao-paulo-4"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := UniqueSlug(tc.input, exists, tc.opts)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %s,\n\tExpected: %s, \n\tGot: %s", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package stringo
 
 import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -11,3 +15,156 @@ func Title(s string) string {
 
 	return c.String(s)
 }
+
+// TitleCaseOptions parametrizes TitleCase. The zero value capitalizes every word, like Title does.
+type TitleCaseOptions struct {
+	// SmallWords keeps articles, conjunctions and short prepositions lowercase, according the language, except the first and last words.
+	// I.E: "the lord of the rings" becomes "The Lord of the Rings", and "rua dos andradas" becomes "Rua dos Andradas"
+	SmallWords bool
+	// PreserveAcronyms keeps fully uppercase words, like "NASA", untouched. It's ignored if the whole string is uppercase.
+	PreserveAcronyms bool
+	// PersonName applies person name rules: particles like "van der", "de" and "da" stay lowercase, unless they start the name,
+	// and prefixes like "Mc", "O'" and "d'" are followed by a capital letter. I.E: "d'Ávila", "McDonald" and "O'Neil"
+	PersonName bool
+}
+
+// titleSmallWords are the words kept lowercase by TitleCaseOptions.SmallWords, by language
+var titleSmallWords = map[string]map[string]bool{
+	"en": wordSet("a", "an", "and", "as", "at", "but", "by", "en", "for", "if", "in", "nor", "of", "off", "on", "or", "per", "so", "the", "to", "up", "via", "vs", "yet"),
+	"pt": wordSet("a", "à", "ao", "aos", "as", "às", "com", "da", "das", "de", "do", "dos", "e", "em", "na", "nas", "no", "nos", "o", "os", "ou", "para", "pela", "pelas", "pelo", "pelos", "por", "um", "uma"),
+	"es": wordSet("a", "al", "con", "de", "del", "e", "el", "en", "la", "las", "los", "o", "para", "por", "u", "un", "una", "y"),
+	"fr": wordSet("à", "au", "aux", "d", "de", "des", "du", "en", "et", "l", "la", "le", "les", "ou", "par", "pour", "sur", "un", "une"),
+	"it": wordSet("a", "al", "con", "da", "dal", "de", "dei", "del", "della", "di", "e", "in", "il", "la", "le", "lo", "o", "per", "su", "un", "una"),
+}
+
+// nameParticles are the surname particles kept lowercase by TitleCaseOptions.PersonName
+var nameParticles = wordSet("af", "av", "bin", "binti", "da", "das", "de", "degli", "dei", "del", "della", "delle", "den", "der", "des",
+	"di", "do", "dos", "du", "e", "el", "la", "las", "le", "los", "ter", "ten", "van", "vander", "ver", "vom", "von", "y", "zu", "zum", "zur")
+
+// nameApostrophePrefixes are the name prefixes followed by an apostrophe. Only "O'" is capitalized, the others are particles.
+var nameApostrophePrefixes = wordSet("o", "d", "l", "dall", "dell", "degl", "sant")
+
+// isApostrophe returns true for the straight and typographic apostrophes
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’' || r == 'ʼ'
+}
+
+// titleCore returns the lowercase word, without leading and trailing punctuation, for small words and particles lookup
+func titleCore(word string, lower cases.Caser) string {
+	return lower.String(strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
+
+// isAcronym returns true if the word has at least two letters, and all of them are uppercase
+func isAcronym(word string) bool {
+	letters := 0
+
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			if !unicode.IsUpper(r) {
+				return false
+			}
+
+			letters++
+		}
+	}
+
+	return letters > 1
+}
+
+// titleNamePart capitalizes a single name part, without hyphens, applying "Mc" and apostrophe prefix rules
+func titleNamePart(part string, first bool, title, lower cases.Caser) string {
+	if i := strings.IndexFunc(part, isApostrophe); i > 0 {
+		_, size := utf8.DecodeRuneInString(part[i:])
+		prefix, rest := lower.String(part[:i]), part[i+size:]
+
+		if nameApostrophePrefixes[prefix] && rest != "" {
+			if prefix == "o" || first {
+				prefix = title.String(prefix)
+			}
+
+			return prefix + part[i:i+size] + title.String(rest)
+		}
+	}
+
+	part = title.String(part)
+
+	if strings.HasPrefix(part, "Mc") && utf8.RuneCountInString(part) > 3 {
+		r, size := utf8.DecodeRuneInString(part[2:])
+
+		return "Mc" + string(unicode.ToUpper(r)) + part[2+size:]
+	}
+
+	return part
+}
+
+// titleName capitalizes a name word, with every hyphenated part handled separately. I.E: "smith-mcdonald" gives "Smith-McDonald"
+func titleName(word string, first bool, title, lower cases.Caser) string {
+	parts := strings.Split(word, "-")
+
+	for i, p := range parts {
+		parts[i] = titleNamePart(p, first && i == 0, title, lower)
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// TitleCase capitalizes the words of the given string, according the given language rules and options
+// Language rules include the Turkish dotted I and the Dutch IJ. I.E: "ijsland" becomes "IJsland" with language.Dutch
+// Spaces are kept as they are.
+// Example: TitleCase("o senhor dos anéis", language.Portuguese, TitleCaseOptions{SmallWords: true}) returns "O Senhor dos Anéis"
+// Example: TitleCase("ludwig VAN beethoven", language.Und, TitleCaseOptions{PersonName: true}) returns "Ludwig van Beethoven"
+func TitleCase(s string, lang language.Tag, opts TitleCaseOptions) string {
+	if s == "" {
+		return s
+	}
+
+	var (
+		title      = cases.Title(lang)
+		lower      = cases.Lower(lang)
+		base, _    = lang.Base()
+		smallWords = titleSmallWords[base.String()]
+		acronyms   = opts.PreserveAcronyms && strings.IndexFunc(s, unicode.IsLower) >= 0
+		words      = strings.Fields(s)
+		sb         strings.Builder
+		w          = 0
+	)
+
+	for len(s) > 0 {
+		// Copies the spaces as they are
+		if i := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsSpace(r) }); i != 0 {
+			if i < 0 {
+				i = len(s)
+			}
+
+			sb.WriteString(s[:i])
+			s = s[i:]
+
+			continue
+		}
+
+		word := words[w]
+		s = s[len(word):]
+
+		first, last := w == 0, w == len(words)-1
+		core := titleCore(word, lower)
+
+		switch {
+		case acronyms && isAcronym(word):
+			sb.WriteString(word)
+		case opts.PersonName && !first && nameParticles[core]:
+			sb.WriteString(lower.String(word))
+		case opts.PersonName:
+			sb.WriteString(titleName(word, first, title, lower))
+		case opts.SmallWords && !first && !last && smallWords[core]:
+			sb.WriteString(lower.String(word))
+		default:
+			sb.WriteString(title.String(word))
+		}
+
+		w++
+	}
+
+	return sb.String()
+}
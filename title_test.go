@@ -0,0 +1,47 @@
+package stringo
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestTitleCase(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		lang           language.Tag
+		opts           TitleCaseOptions
+		expectedOutput string
+	}{
+		{"empty", "", language.Und, TitleCaseOptions{}, ""},
+		{"plain", "the lord of the rings", language.English, TitleCaseOptions{}, "The Lord Of The Rings"},
+		{"english small words", "the lord of the rings", language.English, TitleCaseOptions{SmallWords: true}, "The Lord of the Rings"},
+		{"english last word is capitalized", "what are you looking for", language.English, TitleCaseOptions{SmallWords: true}, "What Are You Looking For"},
+		{"portuguese small words", "o SENHOR dos anéis", language.Portuguese, TitleCaseOptions{SmallWords: true}, "O Senhor dos Anéis"},
+		{"spaces are kept", "  rua   da praia ", language.Portuguese, TitleCaseOptions{SmallWords: true}, "  Rua   da Praia "},
+		{"turkish dotted i", "istanbul izmir", language.Turkish, TitleCaseOptions{}, "İstanbul İzmir"},
+		{"dutch ij", "ijsland en ijmuiden", language.Dutch, TitleCaseOptions{}, "IJsland En IJmuiden"},
+		{"acronyms preserved", "a report from NASA and the FBI", language.English, TitleCaseOptions{SmallWords: true, PreserveAcronyms: true}, "A Report From NASA and the FBI"},
+		{"acronyms ignored for uppercase input", "REPORT FROM NASA", language.English, TitleCaseOptions{PreserveAcronyms: true}, "Report From Nasa"},
+		{"name particles", "ludwig VAN beethoven", language.Und, TitleCaseOptions{PersonName: true}, "Ludwig van Beethoven"},
+		{"dutch particles", "johannes van der waals", language.Und, TitleCaseOptions{PersonName: true}, "Johannes van der Waals"},
+		{"portuguese particles", "JOSÉ DA SILVA DOS SANTOS", language.Portuguese, TitleCaseOptions{PersonName: true}, "José da Silva dos Santos"},
+		{"leading particle", "van gogh", language.Und, TitleCaseOptions{PersonName: true}, "Van Gogh"},
+		{"mc", "ronald mcdonald", language.Und, TitleCaseOptions{PersonName: true}, "Ronald McDonald"},
+		{"o apostrophe", "shaquille o'neal", language.Und, TitleCaseOptions{PersonName: true}, "Shaquille O'Neal"},
+		{"d apostrophe", "luiz d'ávila", language.Portuguese, TitleCaseOptions{PersonName: true}, "Luiz d'Ávila"},
+		{"typographic apostrophe", "D’ÁVILA", language.Portuguese, TitleCaseOptions{PersonName: true}, "D’Ávila"},
+		{"hyphenated", "anne smith-mcdonald", language.Und, TitleCaseOptions{PersonName: true}, "Anne Smith-McDonald"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := TitleCase(tc.input, tc.lang, tc.opts)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %s,\n\tExpected: %s, \n\tGot: %s", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"strings"
 	"unicode/utf8"
 
-	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
 
@@ -32,16 +31,37 @@ const (
 	// TransformHash After process all other flags, applies SHA256 hashing on string for output
 	// 	The routine applies handy.Sha256Hash() on given string
 	TransformHash TransformFlag = 128
-	// TransformTitleCase Makes the string uppercase
+	// TransformTitleCase Capitalizes every word, as Title() does. Use TitleCase() for language and person name rules.
 	// If case transformation flags are combined, the last one remains, considering the following order: TransformTitleCase, TransformLowerCase and TransformUpperCase.
 	TransformTitleCase TransformFlag = 256
 	// TransformRemoveDigits Removes all digit characters, without to touch on any other
 	// If combined with TransformOnlyLettersAndDigits, TransformOnlyDigits or TransformOnlyLetters, it's ineffective
 	TransformRemoveDigits TransformFlag = 512
+	// TransformRemoveAccents Strips diacritics from letters, as RemoveAccents() does
+	TransformRemoveAccents TransformFlag = 1024
+	// TransformTransliterate Converts the string to plain ASCII, as Transliterate() does with language.Und
+	// If combined with TransformRemoveAccents, the accents are removed first
+	TransformTransliterate TransformFlag = 2048
+	// TransformCaseFold Applies NFKC case folding, as CaseFold() does. It's meant for comparisons, like usernames uniqueness.
+	// If combined with other case transformation flags, TransformCaseFold is applied last.
+	TransformCaseFold TransformFlag = 4096
+	// TransformCamelCase Converts the string to camelCase, as ToCamel() does
+	// Case style flags are applied after TransformTitleCase, TransformLowerCase and TransformUpperCase, in the order they're declared here.
+	TransformCamelCase TransformFlag = 8192
+	// TransformPascalCase Converts the string to PascalCase, as ToPascal() does
+	TransformPascalCase TransformFlag = 16384
+	// TransformSnakeCase Converts the string to snake_case, as ToSnake() does
+	TransformSnakeCase TransformFlag = 32768
+	// TransformKebabCase Converts the string to kebab-case, as ToKebab() does
+	TransformKebabCase TransformFlag = 65536
+	// TransformScreamingSnakeCase Converts the string to SCREAMING_SNAKE_CASE, as ToScreamingSnake() does
+	TransformScreamingSnakeCase TransformFlag = 131072
+	// TransformDotCase Converts the string to dot.case, as ToDotCase() does
+	TransformDotCase TransformFlag = 262144
+	// TransformTrainCase Converts the string to Train-Case, as ToTrainCase() does
+	TransformTrainCase TransformFlag = 524288
 )
 
-var caser = cases.Title(language.Und)
-
 // Transform handles a string according given flags/parametrization, as follows:
 // The transformations are made in arbitrary order, what can result in unexpected output. If the order matters, use TransformSerially instead.
 // If maxLen==0, truncation is skipped
@@ -75,15 +95,21 @@ func Transform(s string, maxLen int, transformFlags TransformFlag) string {
 		s = RemoveDigits(s)
 	}
 
+	if (transformFlags & TransformRemoveAccents) == TransformRemoveAccents {
+		s = RemoveAccents(s)
+	}
+
+	if (transformFlags & TransformTransliterate) == TransformTransliterate {
+		s = Transliterate(s, language.Und)
+	}
+
 	// Have to trim before and after, to avoid issues with string truncation and new leading/trailing spaces
 	if (transformFlags & TransformTrim) == TransformTrim {
 		s = strings.TrimSpace(s)
 	}
 
 	if (transformFlags & TransformTitleCase) == TransformTitleCase {
-		title := caser.String(s)
-
-		s = strings.ToLower(title)
+		s = Title(s)
 	}
 
 	if (transformFlags & TransformLowerCase) == TransformLowerCase {
@@ -94,6 +120,38 @@ func Transform(s string, maxLen int, transformFlags TransformFlag) string {
 		s = strings.ToUpper(s)
 	}
 
+	if (transformFlags & TransformCamelCase) == TransformCamelCase {
+		s = ToCamel(s)
+	}
+
+	if (transformFlags & TransformPascalCase) == TransformPascalCase {
+		s = ToPascal(s)
+	}
+
+	if (transformFlags & TransformSnakeCase) == TransformSnakeCase {
+		s = ToSnake(s)
+	}
+
+	if (transformFlags & TransformKebabCase) == TransformKebabCase {
+		s = ToKebab(s)
+	}
+
+	if (transformFlags & TransformScreamingSnakeCase) == TransformScreamingSnakeCase {
+		s = ToScreamingSnake(s)
+	}
+
+	if (transformFlags & TransformDotCase) == TransformDotCase {
+		s = ToDotCase(s)
+	}
+
+	if (transformFlags & TransformTrainCase) == TransformTrainCase {
+		s = ToTrainCase(s)
+	}
+
+	if (transformFlags & TransformCaseFold) == TransformCaseFold {
+		s = CaseFold(s)
+	}
+
 	if (transformFlags & TransformHash) == TransformHash {
 		s = Sha256Hash(s)
 	}
@@ -119,6 +177,7 @@ func Transform(s string, maxLen int, transformFlags TransformFlag) string {
 //          First remove non-digits, then hashes string and after make it all uppercase.
 // If maxLen==0, truncation is skipped
 // Truncation is the last operation
+// Unknown flags are ignored. Use PipelineFromFlags to have them reported, or Pipeline for custom steps.
 func TransformSerially(s string, maxLen int, transformFlags ...TransformFlag) string {
 	if s == "" {
 		return s
@@ -132,16 +191,38 @@ func TransformSerially(s string, maxLen int, transformFlags ...TransformFlag) st
 			s = OnlyDigits(s)
 		case TransformOnlyLetters:
 			s = OnlyLetters(s)
+		case TransformRemoveDigits:
+			s = RemoveDigits(s)
 		case TransformTrim:
 			s = strings.TrimSpace(s)
 		case TransformTitleCase:
-			s = strings.ToTitle(s)
+			s = Title(s)
 		case TransformLowerCase:
 			s = strings.ToLower(s)
 		case TransformUpperCase:
 			s = strings.ToUpper(s)
 		case TransformHash:
 			s = Sha256Hash(s)
+		case TransformRemoveAccents:
+			s = RemoveAccents(s)
+		case TransformTransliterate:
+			s = Transliterate(s, language.Und)
+		case TransformCaseFold:
+			s = CaseFold(s)
+		case TransformCamelCase:
+			s = ToCamel(s)
+		case TransformPascalCase:
+			s = ToPascal(s)
+		case TransformSnakeCase:
+			s = ToSnake(s)
+		case TransformKebabCase:
+			s = ToKebab(s)
+		case TransformScreamingSnakeCase:
+			s = ToScreamingSnake(s)
+		case TransformDotCase:
+			s = ToDotCase(s)
+		case TransformTrainCase:
+			s = ToTrainCase(s)
 		}
 	}
 
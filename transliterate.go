@@ -0,0 +1,240 @@
+package stringo
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// transliterationGeneric holds ASCII replacements for runes that don't decompose into a base letter plus marks
+var transliterationGeneric = map[rune]string{
+	'ß': "ss", 'ẞ': "SS",
+	'Æ': "AE", 'æ': "ae",
+	'Ø': "O", 'ø': "o",
+	'Œ': "OE", 'œ': "oe",
+	'Đ': "D", 'đ': "d",
+	'Ð': "D", 'ð': "d",
+	'Þ': "TH", 'þ': "th",
+	'Ł': "L", 'ł': "l",
+	'Ħ': "H", 'ħ': "h",
+	'ı': "i",
+	'Ŀ': "L", 'ŀ': "l",
+	'ĸ': "k",
+	'Ŋ': "N", 'ŋ': "n",
+	'ſ': "s",
+	'Ŧ': "T", 'ŧ': "t",
+	'Ɖ': "D", 'ɖ': "d",
+	'ƒ': "f",
+	'ª': "a", 'º': "o",
+	'‘': "'", '’': "'", '‚': "'", '‛': "'",
+	'“': `"`, '”': `"`, '„': `"`, '‟': `"`,
+	'«': `"`, '»': `"`, '‹': "'", '›': "'",
+	'–': "-", '—': "-", '‐': "-", '‑': "-", '−': "-",
+	'…': "...",
+	'•': "*",
+	'·': ".",
+	'×': "x",
+	'÷': "/",
+	'€': "EUR", '£': "GBP", '¥': "JPY",
+	'©': "(c)", '®': "(r)", '™': "(tm)",
+	'¼': "1/4", '½': "1/2", '¾': "3/4",
+	' ': " ", ' ': " ", ' ': " ",
+}
+
+// transliterationGerman makes umlauts expand, as Germans do when writing without them
+var transliterationGerman = map[rune]string{
+	'Ä': "AE", 'ä': "ae",
+	'Ö': "OE", 'ö': "oe",
+	'Ü': "UE", 'ü': "ue",
+}
+
+// transliterationDanishNorwegian follows the Danish and Norwegian conventions
+var transliterationDanishNorwegian = map[rune]string{
+	'Å': "AA", 'å': "aa",
+	'Æ': "AE", 'æ': "ae",
+	'Ø': "OE", 'ø': "oe",
+}
+
+// transliterationSwedishFinnish follows the Swedish and Finnish conventions
+var transliterationSwedishFinnish = map[rune]string{
+	'Å': "A", 'å': "a",
+	'Ä': "AE", 'ä': "ae",
+	'Ö': "OE", 'ö': "oe",
+}
+
+// transliterationIcelandic follows the Icelandic conventions
+var transliterationIcelandic = map[rune]string{
+	'Æ': "AE", 'æ': "ae",
+	'Ö': "O", 'ö': "o",
+	'Þ': "TH", 'þ': "th",
+	'Ð': "D", 'ð': "d",
+}
+
+// transliterationCyrillic is based on the Russian romanization used on passports (ICAO 9303)
+var transliterationCyrillic = map[rune]string{
+	'А': "A", 'а': "a", 'Б': "B", 'б': "b", 'В': "V", 'в': "v",
+	'Г': "G", 'г': "g", 'Д': "D", 'д': "d", 'Е': "E", 'е': "e",
+	'Ё': "E", 'ё': "e", 'Ж': "Zh", 'ж': "zh", 'З': "Z", 'з': "z",
+	'И': "I", 'и': "i", 'Й': "I", 'й': "i", 'К': "K", 'к': "k",
+	'Л': "L", 'л': "l", 'М': "M", 'м': "m", 'Н': "N", 'н': "n",
+	'О': "O", 'о': "o", 'П': "P", 'п': "p", 'Р': "R", 'р': "r",
+	'С': "S", 'с': "s", 'Т': "T", 'т': "t", 'У': "U", 'у': "u",
+	'Ф': "F", 'ф': "f", 'Х': "Kh", 'х': "kh", 'Ц': "Ts", 'ц': "ts",
+	'Ч': "Ch", 'ч': "ch", 'Ш': "Sh", 'ш': "sh", 'Щ': "Shch", 'щ': "shch",
+	'Ъ': "Ie", 'ъ': "ie", 'Ы': "Y", 'ы': "y", 'Ь': "", 'ь': "",
+	'Э': "E", 'э': "e", 'Ю': "Iu", 'ю': "iu", 'Я': "Ia", 'я': "ia",
+	'Є': "Ie", 'є': "ie", 'І': "I", 'і': "i", 'Ї': "I", 'ї': "i",
+	'Ґ': "G", 'ґ': "g", 'Ў': "U", 'ў': "u", 'Ђ': "D", 'ђ': "d",
+	'Ј': "J", 'ј': "j", 'Љ': "Lj", 'љ': "lj", 'Њ': "Nj", 'њ': "nj",
+	'Ћ': "C", 'ћ': "c", 'Џ': "Dz", 'џ': "dz", 'Ѓ': "G", 'ѓ': "g",
+	'Ќ': "K", 'ќ': "k", 'Ѕ': "Dz", 'ѕ': "dz",
+}
+
+// transliterationUkrainian overrides the letters that Ukrainian reads differently from Russian
+var transliterationUkrainian = map[rune]string{
+	'Г': "H", 'г': "h", 'И': "Y", 'и': "y", 'Й': "I", 'й': "i",
+	'Є': "Ye", 'є': "ie", 'Ї': "Yi", 'ї': "i", 'Х': "Kh", 'х': "kh",
+}
+
+// transliterationBulgarian overrides the letters that Bulgarian reads differently from Russian
+var transliterationBulgarian = map[rune]string{
+	'Щ': "Sht", 'щ': "sht", 'Ъ': "A", 'ъ': "a", 'Ь': "Y", 'ь': "y",
+	'Ю': "Yu", 'ю': "yu", 'Я': "Ya", 'я': "ya", 'Х': "H", 'х': "h",
+}
+
+// transliterationGreek is based on ELOT 743
+var transliterationGreek = map[rune]string{
+	'Α': "A", 'α': "a", 'Β': "V", 'β': "v", 'Γ': "G", 'γ': "g",
+	'Δ': "D", 'δ': "d", 'Ε': "E", 'ε': "e", 'Ζ': "Z", 'ζ': "z",
+	'Η': "I", 'η': "i", 'Θ': "Th", 'θ': "th", 'Ι': "I", 'ι': "i",
+	'Κ': "K", 'κ': "k", 'Λ': "L", 'λ': "l", 'Μ': "M", 'μ': "m",
+	'Ν': "N", 'ν': "n", 'Ξ': "X", 'ξ': "x", 'Ο': "O", 'ο': "o",
+	'Π': "P", 'π': "p", 'Ρ': "R", 'ρ': "r", 'Σ': "S", 'σ': "s",
+	'ς': "s", 'Τ': "T", 'τ': "t", 'Υ': "Y", 'υ': "y", 'Φ': "F",
+	'φ': "f", 'Χ': "Ch", 'χ': "ch", 'Ψ': "Ps", 'ψ': "ps", 'Ω': "O",
+	'ω': "o",
+}
+
+// transliterationTable returns the language specific replacements, which take precedence over the generic ones
+func transliterationTable(lang language.Tag) map[rune]string {
+	base, _ := lang.Base()
+
+	switch base.String() {
+	case "de":
+		return transliterationGerman
+	case "da", "nb", "nn", "no":
+		return transliterationDanishNorwegian
+	case "sv", "fi":
+		return transliterationSwedishFinnish
+	case "is":
+		return transliterationIcelandic
+	case "uk":
+		return transliterationUkrainian
+	case "bg":
+		return transliterationBulgarian
+	}
+
+	return nil
+}
+
+// transliterationCase adapts multi-letter replacements of uppercase runes to the following letter case
+// I.E: "Ärger" gives "Aerger", not "AErger", and "ЩУКИН" gives "SHCHUKIN", not "ShchUKIN"
+func transliterationCase(t string, r rune, next []rune) string {
+	if len(t) < 2 || !unicode.IsUpper(r) || len(next) == 0 {
+		return t
+	}
+
+	switch {
+	case unicode.IsLower(next[0]):
+		return t[:1] + strings.ToLower(t[1:])
+	case unicode.IsUpper(next[0]):
+		return strings.ToUpper(t)
+	}
+
+	return t
+}
+
+// RemoveAccents strips diacritics (accents, cedillas, tildes, etc.) from letters, keeping everything else untouched
+// Letters are decomposed (NFD), their combining marks are discarded, and the result is recomposed (NFC)
+// Example: RemoveAccents("São Paulo") returns "Sao Paulo"
+// Observe that letters without decomposition, like "ß" or "ø", are kept. Use Transliterate to get plain ASCII.
+func RemoveAccents(s string) string {
+	if s == "" {
+		return s
+	}
+
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+	result, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+
+	return result
+}
+
+// Transliterate converts the given string to plain ASCII, according the conventions of the given language
+// Latin letters lose their accents, while Cyrillic and Greek letters are romanized.
+// German, Nordic, Ukrainian and Bulgarian have specific rules. I.E: "ö" becomes "oe" for language.German, and "o" otherwise.
+// Vietnamese is fully covered by the generic rules. Runes without ASCII representation are removed.
+// Example: Transliterate("Straße", language.Und) returns "Strasse"
+func Transliterate(s string, lang language.Tag) string {
+	if s == "" {
+		return s
+	}
+
+	var (
+		specific = transliterationTable(lang)
+		rs       = []rune(norm.NFC.String(s))
+		sb       strings.Builder
+	)
+
+	for i, r := range rs {
+		if r <= unicode.MaxASCII {
+			sb.WriteRune(r)
+			continue
+		}
+
+		t, ok := specific[r]
+		if !ok {
+			t, ok = transliterationGeneric[r]
+		}
+
+		if !ok {
+			t, ok = transliterationCyrillic[r]
+		}
+
+		if !ok {
+			t, ok = transliterationGreek[r]
+		}
+
+		if ok {
+			sb.WriteString(transliterationCase(t, r, rs[i+1:]))
+			continue
+		}
+
+		// Decomposes the rune, hoping for an ASCII base letter followed by marks, like Vietnamese "ễ"
+		for _, d := range norm.NFKD.String(string(r)) {
+			switch {
+			case d <= unicode.MaxASCII:
+				sb.WriteRune(d)
+			case unicode.Is(unicode.Mn, d):
+				continue
+			default:
+				if t, ok := transliterationGeneric[d]; ok {
+					sb.WriteString(t)
+				} else if t, ok := transliterationGreek[d]; ok {
+					sb.WriteString(t)
+				} else if t, ok := transliterationCyrillic[d]; ok {
+					sb.WriteString(t)
+				}
+			}
+		}
+	}
+
+	return sb.String()
+}
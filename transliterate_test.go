@@ -0,0 +1,91 @@
+package stringo
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestRemoveAccents(t *testing.T) {
+	tcs := []defaultTestStruct{
+		{"empty", "", ""},
+		{"ascii only", "Sao Paulo", "Sao Paulo"},
+		{"portuguese", "São Paulo, Ceará, Maranhão", "Sao Paulo, Ceara, Maranhao"},
+		{"french", "Élève à l'hôpital, garçon", "Eleve a l'hopital, garcon"},
+		{"vietnamese", "Tiếng Việt", "Tieng Viet"},
+		{"non decomposable letters are kept", "Straße Øresund", "Straße Øresund"},
+		{"decomposed input", "São", "Sao"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := RemoveAccents(tc.input.(string))
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %s,\n\tExpected: %s, \n\tGot: %s", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestTransliterate(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		lang           language.Tag
+		expectedOutput string
+	}{
+		{"empty", "", language.Und, ""},
+		{"german eszett", "Straße", language.Und, "Strasse"},
+		{"german umlauts", "Müller Köln Ärger", language.German, "Mueller Koeln Aerger"},
+		{"umlauts without language", "Müller Köln", language.Und, "Muller Koln"},
+		{"danish", "Ærø Ålborg Søren", language.Danish, "Aeroe Aalborg Soeren"},
+		{"swedish", "Åsa Öberg", language.Swedish, "Asa Oeberg"},
+		{"generic nordic", "Søren Ærø", language.Und, "Soren Aero"},
+		{"russian", "Москва Щукин", language.Russian, "Moskva Shchukin"},
+		{"russian uppercase", "ЩУКИН", language.Russian, "SHCHUKIN"},
+		{"ukrainian", "Київ Григорій", language.Ukrainian, "Kyiv Hryhorii"},
+		{"greek", "Αθήνα Θεσσαλονίκη", language.Greek, "Athina Thessaloniki"},
+		{"vietnamese", "Đặng Thị Ngọc Thịnh", language.Vietnamese, "Dang Thi Ngoc Thinh"},
+		{"polish", "Łódź", language.Polish, "Lodz"},
+		{"typography", "“Olá” – disse…", language.Und, `"Ola" - disse...`},
+		{"untranslatable runes are removed", "日本 Tokyo", language.Und, " Tokyo"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Transliterate(tc.input, tc.lang)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %s,\n\tExpected: %s, \n\tGot: %s", tc.input, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestTransformAccents(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		flags          TransformFlag
+		expectedOutput string
+	}{
+		{"remove accents", " São Paulo ", TransformRemoveAccents | TransformTrim, "Sao Paulo"},
+		{"transliterate and lowercase", "Straße", TransformTransliterate | TransformLowerCase, "strasse"},
+		{"transliterate only letters", "Ñandú 123", TransformTransliterate | TransformOnlyLetters, "Nandu"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			tr := Transform(tc.input, 0, tc.flags)
+
+			if tr != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s, \n\tInput: %s, \n\tflags: %d", tc.expectedOutput, tr, tc.input, tc.flags)
+			}
+		})
+	}
+
+	if tr := TransformSerially("São Paulo", 0, TransformTransliterate, TransformUpperCase); tr != "SAO PAULO" {
+		t.Errorf("Test has failed!\n\tExpected: %s, \n\tGot: %s", "SAO PAULO", tr)
+	}
+}
@@ -0,0 +1,401 @@
+package stringo
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WrapOptions parametrizes Wrap. The zero value just breaks lines.
+type WrapOptions struct {
+	// Prefix starts every line, and counts in the width. I.E: "> " for quoted email replies, or "// " for code comments
+	Prefix string
+	// Indent starts the first line of every paragraph, after the prefix
+	Indent string
+	// HangingIndent starts the other lines of every paragraph, after the prefix. I.E: to align list items
+	HangingIndent string
+	// Hyphenate breaks words longer than the line, adding a hyphen. Otherwise, long words overflow the line.
+	Hyphenate bool
+}
+
+// wrapSegment is an unbreakable piece of text, followed by the spaces after it, if any
+type wrapSegment struct {
+	word  string
+	space string
+}
+
+// wrapNoLineStart are the characters which can't start a line, like closing punctuation and CJK small kana marks
+const wrapNoLineStart = ")]}»›”’.,;:!?%…、。，．：；！？）」』】〕〉》｝・ー゛゜ぁぃぅぇぉっゃゅょァィゥェォッャュョ"
+
+// wrapNoLineEnd are the characters which can't end a line, like opening punctuation
+const wrapNoLineEnd = "([{«‹“‘$（「『【〔〈《｛"
+
+// isWrapIdeographic returns true for characters which allow line breaks around them, without spaces, like Chinese and Japanese
+func isWrapIdeographic(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+// isWrapHyphen returns true for the hyphens which allow a line break after them
+func isWrapHyphen(r rune) bool {
+	return r == '-' || r == '‐' || r == '–'
+}
+
+// wrapBreakBetween tells if a line may break between the given grapheme clusters, with no space between them
+// It's a simplified version of the Unicode line breaking algorithm (UAX #14)
+func wrapBreakBetween(word, prev, next string) bool {
+	p, _ := utf8.DecodeLastRuneInString(prev)
+	n, _ := utf8.DecodeRuneInString(next)
+
+	switch {
+	case strings.ContainsRune(wrapNoLineStart, n), strings.ContainsRune(wrapNoLineEnd, p):
+		return false
+	case isWrapHyphen(p):
+		// Only hyphens inside words, like "well-known". Not "-5" or "--flag"
+		before, _ := utf8.DecodeLastRuneInString(strings.TrimSuffix(word, prev))
+
+		return unicode.IsLetter(before) && unicode.IsLetter(n)
+	}
+
+	return isWrapIdeographic(p) || isWrapIdeographic(n)
+}
+
+// wrapSegments splits a paragraph into unbreakable segments. Leading spaces are discarded.
+func wrapSegments(paragraph string) []wrapSegment {
+	var (
+		segments []wrapSegment
+		cur      wrapSegment
+		prev     string
+	)
+
+	for _, g := range Graphemes(paragraph) {
+		r, _ := utf8.DecodeRuneInString(g)
+
+		switch {
+		case unicode.IsSpace(r):
+			if cur.word != "" {
+				cur.space += g
+			}
+
+			continue
+		case cur.word == "":
+			cur.word = g
+		case cur.space != "" || wrapBreakBetween(cur.word, prev, g):
+			segments = append(segments, cur)
+			cur = wrapSegment{word: g}
+		default:
+			cur.word += g
+		}
+
+		prev = g
+	}
+
+	if cur.word != "" {
+		segments = append(segments, cur)
+	}
+
+	return segments
+}
+
+// wrapHyphenate breaks the head of an overlong word to fit the given width, followed by a hyphen, and returns it and the rest of the word
+func wrapHyphenate(word string, width int) (string, string) {
+	n := width
+
+	// Leaves room for the hyphen
+	if n > 1 {
+		n--
+	}
+
+	piece := TruncateWidth(word, n)
+
+	if piece == "" {
+		// A wide character doesn't fit a single column line
+		piece = word[:graphemeClusterLen(word)]
+	}
+
+	rest := word[len(piece):]
+
+	if r, _ := utf8.DecodeLastRuneInString(piece); n < width && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+		piece += "-"
+	}
+
+	return piece, rest
+}
+
+// wrapLines accumulates wrapped lines, tracking the current one
+type wrapLines struct {
+	opts      WrapOptions
+	width     int
+	lines     []string
+	prefix    string
+	line      strings.Builder
+	lineWidth int
+}
+
+// available returns how many columns the current line has, after the prefix
+func (wl *wrapLines) available() int {
+	if a := wl.width - DisplayWidth(wl.prefix); a > 0 {
+		return a
+	}
+
+	return 1
+}
+
+// write appends text to the current line
+func (wl *wrapLines) write(s string) {
+	wl.line.WriteString(s)
+	wl.lineWidth += DisplayWidth(s)
+}
+
+// flush ends the current line, starting a new one with the hanging indent
+func (wl *wrapLines) flush() {
+	wl.lines = append(wl.lines, wl.prefix+wl.line.String())
+	wl.line.Reset()
+	wl.lineWidth = 0
+	wl.prefix = wl.opts.Prefix + wl.opts.HangingIndent
+}
+
+// wrapParagraph breaks a single paragraph, without line feeds, into lines
+func wrapParagraph(paragraph string, width int, opts WrapOptions) []string {
+	wl := &wrapLines{opts: opts, width: width, prefix: opts.Prefix + opts.Indent}
+	segments := wrapSegments(paragraph)
+
+	if len(segments) == 0 {
+		return []string{strings.TrimRightFunc(wl.prefix, unicode.IsSpace)}
+	}
+
+	space := ""
+
+	for _, seg := range segments {
+		w := DisplayWidth(seg.word)
+
+		if wl.lineWidth > 0 && wl.lineWidth+DisplayWidth(space)+w > wl.available() {
+			wl.flush()
+		}
+
+		switch {
+		case wl.lineWidth > 0:
+			wl.write(space + seg.word)
+		case w > wl.available() && opts.Hyphenate:
+			word := seg.word
+
+			// the hanging indent may change the available width after the first line, so pieces are cut one at a time
+			for DisplayWidth(word) > wl.available() {
+				piece, rest := wrapHyphenate(word, wl.available())
+				wl.write(piece)
+				wl.flush()
+				word = rest
+			}
+
+			wl.write(word)
+		default:
+			wl.write(seg.word)
+		}
+
+		space = seg.space
+	}
+
+	wl.flush()
+
+	return wl.lines
+}
+
+// Wrap breaks the given text into lines no wider than width columns of a monospaced display
+// Lines break at spaces, after hyphens inside words, and between Chinese and Japanese characters, but never before closing
+// punctuation or after opening punctuation. Existing line feeds are kept, and each line is wrapped as a paragraph.
+// width<1 means there's no maximum width, and the text is returned as it is.
+// Example: Wrap("The quick brown fox jumps over the lazy dog", 15, WrapOptions{}) returns "The quick brown\nfox jumps over\nthe lazy dog"
+// Example: Wrap("Lorem ipsum dolor sit amet", 13, WrapOptions{Prefix: "> "}) returns "> Lorem ipsum\n> dolor sit\n> amet"
+func Wrap(s string, width int, opts WrapOptions) string {
+	if width < 1 {
+		return s
+	}
+
+	var lines []string
+
+	for _, paragraph := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		lines = append(lines, wrapParagraph(paragraph, width, opts)...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// justifyLine stretches the spaces between words, so the line takes exactly width columns
+// Lines with a single word, or wider than width, are returned as they are.
+func justifyLine(line string, width int) string {
+	words := strings.Fields(line)
+	gaps := len(words) - 1
+
+	if gaps < 1 {
+		return line
+	}
+
+	extra := width - DisplayWidth(strings.Join(words, ""))
+
+	if extra < gaps {
+		return line
+	}
+
+	var sb strings.Builder
+
+	for i, w := range words {
+		sb.WriteString(w)
+
+		if i < gaps {
+			n := extra / gaps
+
+			if i < extra%gaps {
+				n++
+			}
+
+			sb.WriteString(strings.Repeat(" ", n))
+		}
+	}
+
+	return sb.String()
+}
+
+// Justify wraps the given text into lines of width columns, stretching the spaces between words so lines are flush on both sides
+// The last line of each paragraph is left aligned, as usual in typesetting.
+// Example: Justify("The quick brown fox jumps over the lazy dog", 16) returns "The  quick brown\nfox  jumps  over\nthe lazy dog"
+func Justify(s string, width int) string {
+	if width < 1 {
+		return s
+	}
+
+	var lines []string
+
+	for _, paragraph := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		pl := wrapParagraph(paragraph, width, WrapOptions{})
+
+		for i := range pl[:len(pl)-1] {
+			pl[i] = justifyLine(pl[i], width)
+		}
+
+		lines = append(lines, pl...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// padding returns the given pad rune repeated enough to fill n columns
+// Wide pad runes may leave a column unfilled, which is filled with a space.
+func padding(n int, pad rune) string {
+	if n < 1 {
+		return ""
+	}
+
+	w := runeWidth(pad)
+
+	if w < 1 {
+		pad, w = ' ', 1
+	}
+
+	return strings.Repeat(string(pad), n/w) + strings.Repeat(" ", n%w)
+}
+
+// PadLeft right aligns the given string in width columns of a monospaced display, adding pad runes to the left
+// Strings already wider than width are returned as they are.
+// Example: PadLeft("42", 5, '0') returns "00042"
+func PadLeft(s string, width int, pad rune) string {
+	return padding(width-DisplayWidth(s), pad) + s
+}
+
+// PadRight left aligns the given string in width columns of a monospaced display, adding pad runes to the right
+// Strings already wider than width are returned as they are.
+// Example: PadRight("日本", 6, '.') returns "日本.."
+func PadRight(s string, width int, pad rune) string {
+	return s + padding(width-DisplayWidth(s), pad)
+}
+
+// Center centers the given string in width columns of a monospaced display, adding pad runes on both sides
+// When the padding can't be evenly split, the extra column goes to the right.
+// Example: Center("go", 7, '*') returns "**go***"
+func Center(s string, width int, pad rune) string {
+	n := width - DisplayWidth(s)
+
+	if n < 1 {
+		return s
+	}
+
+	return padding(n/2, pad) + s + padding(n-n/2, pad)
+}
+
+type ColumnAlign uint8
+
+const (
+	ColumnAlignLeft   ColumnAlign = 0
+	ColumnAlignRight  ColumnAlign = 1
+	ColumnAlignCenter ColumnAlign = 2
+)
+
+// FormatColumns renders the given rows as a plain text table, with every column as wide as its widest cell
+// Columns are joined by sep and aligned according aligns, in column order. Missing aligns, as well as missing cells, mean left alignment and empty cells.
+// Padding isn't added after the last column, so lines don't end in padding, but spaces inside cells are kept.
+// Example: FormatColumns([][]string{{"item", "qty"}, {"apple", "3"}}, "  ", ColumnAlignLeft, ColumnAlignRight) returns "item   qty\napple    3"
+func FormatColumns(rows [][]string, sep string, aligns ...ColumnAlign) string {
+	var widths []int
+
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+
+			if w := DisplayWidth(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	lines := make([]string, 0, len(rows))
+
+	for _, row := range rows {
+		var sb strings.Builder
+
+		for i, width := range widths {
+			cell := ""
+
+			if i < len(row) {
+				cell = row[i]
+			}
+
+			align := ColumnAlignLeft
+
+			if i < len(aligns) {
+				align = aligns[i]
+			}
+
+			if i > 0 {
+				sb.WriteString(sep)
+			}
+
+			n := width - DisplayWidth(cell)
+			left := 0
+
+			switch align {
+			case ColumnAlignRight:
+				left = n
+			case ColumnAlignCenter:
+				left = n / 2
+			}
+
+			// the last column isn't padded after its content, so lines don't end in padding, but cells keep their own spaces
+			if i == len(widths)-1 {
+				if cell != "" {
+					sb.WriteString(padding(left, ' '))
+				}
+
+				sb.WriteString(cell)
+				continue
+			}
+
+			sb.WriteString(padding(left, ' '))
+			sb.WriteString(cell)
+			sb.WriteString(padding(n-left, ' '))
+		}
+
+		lines = append(lines, sb.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,129 @@
+package stringo
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+	const fox = "The quick brown fox jumps over the lazy dog"
+
+	tcs := []struct {
+		summary        string
+		input          string
+		width          int
+		opts           WrapOptions
+		expectedOutput string
+	}{
+		{"empty", "", 10, WrapOptions{}, ""},
+		{"no width", fox, 0, WrapOptions{}, fox},
+		{"fits", "The quick", 10, WrapOptions{}, "The quick"},
+		{"words", fox, 15, WrapOptions{}, "The quick brown\nfox jumps over\nthe lazy dog"},
+		{"collapses spaces at breaks", "The quick    brown", 10, WrapOptions{}, "The quick\nbrown"},
+		{"keeps paragraphs", "The quick\n\nbrown fox", 20, WrapOptions{}, "The quick\n\nbrown fox"},
+		{"crlf", "The quick\r\nbrown fox", 20, WrapOptions{}, "The quick\nbrown fox"},
+		{"prefix", "Lorem ipsum dolor sit amet", 13, WrapOptions{Prefix: "> "}, "> Lorem ipsum\n> dolor sit\n> amet"},
+		{"prefix on blank lines", "Lorem\n\nipsum", 13, WrapOptions{Prefix: "> "}, "> Lorem\n>\n> ipsum"},
+		{"hanging indent", "- The quick brown fox jumps", 12, WrapOptions{HangingIndent: "  "}, "- The quick\n  brown fox\n  jumps"},
+		{"indent", "The quick brown fox", 12, WrapOptions{Indent: "    "}, "    The\nquick brown\nfox"},
+		{"breaks after hyphen", "a well-known fact", 8, WrapOptions{}, "a well-\nknown\nfact"},
+		{"keeps flags together", "run --verbose now", 8, WrapOptions{}, "run\n--verbose\nnow"},
+		{"long word overflows", "see supercalifragilistic", 10, WrapOptions{}, "see\nsupercalifragilistic"},
+		{"hyphenates long words", "see supercalifragilistic", 10, WrapOptions{Hyphenate: true}, "see\nsupercali-\nfragilist-\nic"},
+		{"hyphenates with wider hanging indent", "supercalifragilistic", 10, WrapOptions{Hyphenate: true, HangingIndent: "    "},
+			"supercali-\n    fragi-\n    listic"},
+		{"ideographic", "日本語のテキストです", 8, WrapOptions{}, "日本語の\nテキスト\nです"},
+		{"no line start with closing punctuation", "日本語です。", 10, WrapOptions{}, "日本語で\nす。"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Wrap(tc.input, tc.width, tc.opts)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q, %d,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.width, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestJustify(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		input          string
+		width          int
+		expectedOutput string
+	}{
+		{"empty", "", 10, ""},
+		{"single line", "The quick", 20, "The quick"},
+		{"fox", "The quick brown fox jumps over the lazy dog", 16, "The  quick brown\nfox  jumps  over\nthe lazy dog"},
+		{"single word lines", "supercalifragilistic is long", 10, "supercalifragilistic\nis long"},
+		{"paragraphs", "a b c\nd e f", 3, "a b\nc\nd e\nf"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := Justify(tc.input, tc.width)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q, %d,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.width, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestPadding(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		fn             func(string, int, rune) string
+		input          string
+		width          int
+		pad            rune
+		expectedOutput string
+	}{
+		{"left", PadLeft, "42", 5, '0', "00042"},
+		{"left wider", PadLeft, "123456", 5, '0', "123456"},
+		{"right", PadRight, "ab", 4, ' ', "ab  "},
+		{"right wide", PadRight, "日本", 6, '.', "日本.."},
+		{"right wide pad", PadRight, "a", 4, '＊', "a＊ "},
+		{"center", Center, "go", 7, '*', "**go***"},
+		{"center even", Center, "go", 6, '*', "**go**"},
+		{"center wide", Center, "日本", 8, '-', "--日本--"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := tc.fn(tc.input, tc.width, tc.pad)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q, %d,\n\tExpected: %q, \n\tGot: %q", tc.input, tc.width, tc.expectedOutput, r)
+			}
+		})
+	}
+}
+
+func TestFormatColumns(t *testing.T) {
+	tcs := []struct {
+		summary        string
+		rows           [][]string
+		sep            string
+		aligns         []ColumnAlign
+		expectedOutput string
+	}{
+		{"empty", nil, " ", nil, ""},
+		{"left", [][]string{{"a", "bb"}, {"ccc", "d"}}, " | ", nil, "a   | bb\nccc | d"},
+		{"right", [][]string{{"item", "qty"}, {"apple", "3"}}, "  ", []ColumnAlign{ColumnAlignLeft, ColumnAlignRight}, "item   qty\napple    3"},
+		{"center", [][]string{{"x", "name"}, {"y", "a"}}, " ", []ColumnAlign{ColumnAlignLeft, ColumnAlignCenter}, "x name\ny  a"},
+		{"missing cells", [][]string{{"a", "b", "c"}, {"d"}}, ",", nil, "a,b,c\nd, ,"},
+		{"keeps trailing spaces of cells", [][]string{{"a", "b  "}, {"cc", "d"}}, " ", nil, "a  b  \ncc d"},
+		{"keeps trailing spaces of centered cells", [][]string{{"a", "bb "}, {"cc", "d"}}, " ", []ColumnAlign{ColumnAlignLeft, ColumnAlignCenter}, "a  bb \ncc  d"},
+		{"wide cells", [][]string{{"日本", "1"}, {"x", "2"}}, " ", nil, "日本 1\nx    2"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.summary, func(t *testing.T) {
+			r := FormatColumns(tc.rows, tc.sep, tc.aligns...)
+
+			if r != tc.expectedOutput {
+				t.Errorf("Test has failed!\n\tInput: %q,\n\tExpected: %q, \n\tGot: %q", tc.rows, tc.expectedOutput, r)
+			}
+		})
+	}
+}